@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var aliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "Manage short aliases for project names",
+	Long: `Aliases are short names accepted wherever a project name is, e.g. 'dockyard start a'. They are stored in projects.json.
+A project name always wins over an alias with the same name.`,
+}
+
+var aliasSetCmd = &cobra.Command{
+	Use:   "set [alias] [project]",
+	Short: "Add an alias for a project",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		alias := args[0]
+		projectName, ok := resolveProjectName(args[1])
+		if !ok {
+			return
+		}
+
+		if err := docker.SetAlias(alias, projectName); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Printf("✅ '%s' now refers to project '%s'\n", alias, projectName)
+	},
+}
+
+var aliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List project aliases",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		found := false
+		for _, projectName := range docker.GetSortedProjectNames() {
+			aliases := docker.ProjectsSettings[projectName].Aliases
+			if len(aliases) == 0 {
+				continue
+			}
+			found = true
+			fmt.Printf("🏷️  %-25s %s\n", projectName, strings.Join(aliases, ", "))
+		}
+
+		if !found {
+			fmt.Println("📭 No aliases defined")
+			fmt.Println("💡 Tip: Run 'dockyard alias set [alias] [project]' to add one")
+		}
+	},
+}
+
+var aliasRemoveCmd = &cobra.Command{
+	Use:   "remove [alias]",
+	Short: "Remove an alias",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, err := docker.RemoveAlias(args[0])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Printf("✅ Removed alias '%s' of project '%s'\n", args[0], projectName)
+	},
+}
+
+func init() {
+	aliasCmd.AddCommand(aliasSetCmd)
+	aliasCmd.AddCommand(aliasListCmd)
+	aliasCmd.AddCommand(aliasRemoveCmd)
+	rootCmd.AddCommand(aliasCmd)
+}
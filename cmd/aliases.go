@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	aliasesBlockStart = "# >>> dockyard aliases >>>"
+	aliasesBlockEnd   = "# <<< dockyard aliases <<<"
+)
+
+var aliasesShell string
+var aliasesCommands []string
+
+// aliasNameUnsafe matches characters not allowed in a shell alias name
+var aliasNameUnsafe = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// shellPlainWord matches values that need no quoting in any supported shell
+var shellPlainWord = regexp.MustCompile(`^[A-Za-z0-9_./:@%+=-]+$`)
+
+var aliasesCmd = &cobra.Command{
+	Use:   "aliases",
+	Short: "Generate shell aliases for registered projects",
+}
+
+var aliasesGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Print shell aliases for the common commands of every project",
+	Long: `Print an alias per project and command, e.g. alias startapi='dockyard start api', for every registered project.
+The output is wrapped in a marker block so it can be regenerated and re-sourced after adding projects:
+
+  dockyard aliases generate > ~/.dockyard_aliases && source ~/.dockyard_aliases
+
+The shell defaults to the one in $SHELL; bash, zsh and fish are supported.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		shell := aliasesShell
+		if shell == "" {
+			shell = filepath.Base(os.Getenv("SHELL"))
+		}
+		if shell != "bash" && shell != "zsh" && shell != "fish" {
+			if aliasesShell != "" {
+				fmt.Fprintf(os.Stderr, "❌ Unsupported shell '%s', use bash, zsh or fish\n", aliasesShell)
+				setExitCode(ExitFailure)
+				return
+			}
+			shell = "bash"
+		}
+
+		for _, command := range aliasesCommands {
+			if err := validateAliasCommand(command); err != nil {
+				fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+		}
+
+		projectNames := docker.GetSortedProjectNames()
+		if len(projectNames) == 0 {
+			fmt.Fprintln(os.Stderr, "📭 No projects registered")
+			return
+		}
+
+		fmt.Println(aliasesBlockStart)
+		fmt.Printf("# Generated for %s by 'dockyard aliases generate', re-run it after adding projects\n", shell)
+		// Sanitizing can map two project names to the same alias, e.g. my-app and my.app
+		generated := make(map[string]string)
+		for _, projectName := range projectNames {
+			for _, command := range aliasesCommands {
+				name := command + aliasNameUnsafe.ReplaceAllString(projectName, "_")
+				if other, taken := generated[name]; taken {
+					fmt.Fprintf(os.Stderr, "⚠️  Skipped alias %s for project %s, it is already used for project %s\n", name, projectName, other)
+					continue
+				}
+				generated[name] = projectName
+				target := fmt.Sprintf("dockyard %s %s", command, shellQuote(shell, projectName))
+				if shell == "fish" {
+					fmt.Printf("alias %s %s\n", name, shellQuote(shell, target))
+				} else {
+					fmt.Printf("alias %s=%s\n", name, shellQuote(shell, target))
+				}
+			}
+		}
+		fmt.Println(aliasesBlockEnd)
+	},
+}
+
+// validateAliasCommand checks that a --commands value is a dockyard command taking a project
+// and can be part of an alias name
+func validateAliasCommand(command string) error {
+	if command == "" || aliasNameUnsafe.MatchString(command) {
+		return fmt.Errorf("invalid command '%s' in --commands, use command names such as start or logs", command)
+	}
+	found, _, err := rootCmd.Find([]string{command})
+	if err != nil || found == rootCmd || found.Name() != command {
+		return fmt.Errorf("unknown command '%s' in --commands", command)
+	}
+	if usage := strings.Fields(found.Use); len(usage) < 2 || usage[1] != "[project]" {
+		return fmt.Errorf("command '%s' in --commands does not take a project", command)
+	}
+	return nil
+}
+
+// shellQuote wraps a value in single quotes for the given shell, leaving plain words unquoted
+func shellQuote(shell, value string) string {
+	if shellPlainWord.MatchString(value) {
+		return value
+	}
+	if shell == "fish" {
+		return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(value) + "'"
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func init() {
+	aliasesGenerateCmd.Flags().StringVar(&aliasesShell, "shell", "", "Shell syntax to emit: bash, zsh or fish (default from $SHELL)")
+	aliasesGenerateCmd.Flags().StringSliceVar(&aliasesCommands, "commands", []string{"start", "stop", "restart", "logs", "status"}, "Commands to generate aliases for")
+	aliasesCmd.AddCommand(aliasesGenerateCmd)
+	rootCmd.AddCommand(aliasesCmd)
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var attachDetachKeys string
+
+var attachCmd = &cobra.Command{
+	Use:   "attach [project] [service]",
+	Short: "Attach to the main process of a running service",
+	Long: `Connect your terminal to the stdin, stdout and stderr of a service container's main process for interactive debugging. Unlike exec this does not start a new process.
+Detach with the detach key sequence (Ctrl-P Ctrl-Q unless --detach-keys is given) when the container has a TTY, otherwise with Ctrl-C, which is not forwarded to the container.
+The container's TTY follows the size of your terminal.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+		service := args[1]
+
+		if err := docker.ValidateDetachKeys(attachDetachKeys); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		target, err := cm.FindAttachTarget(projectDir, service)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("🔌 Attaching to %s\n", target.Container)
+		if target.TTY {
+			fmt.Printf("⚠️  Detach with %s, typing exit or Ctrl-D may stop the main process\n", attachDetachKeys)
+		} else {
+			fmt.Println("⚠️  The container has no TTY, detach with Ctrl-C (it is not forwarded to the container)")
+		}
+
+		if err := cm.AttachToService(projectDir, service, attachDetachKeys); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	attachCmd.Flags().StringVar(&attachDetachKeys, "detach-keys", docker.DefaultDetachKeys, "Key sequence for detaching from the container")
+	rootCmd.AddCommand(attachCmd)
+}
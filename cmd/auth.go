@@ -4,6 +4,7 @@ import (
 	"dockyard/pkg/docker"
 	"fmt"
 	"os/exec"
+	"slices"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -19,6 +20,61 @@ var authCmd = &cobra.Command{
 	},
 }
 
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the registries docker has credentials for",
+	Long:  `List the registries with credentials stored in the docker config file, its credential helpers or its credential store.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		checkAuthStatus()
+	},
+}
+
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout [registry]",
+	Short: "Remove the stored credentials of a registry",
+	Long:  `Log out of a registry with docker logout. Without a registry, pick one of the registries with stored credentials.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var registry string
+		if len(args) == 1 {
+			registry = args[0]
+		} else {
+			credentials, err := docker.StoredCredentials()
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+			if len(credentials) == 0 {
+				fmt.Println("📭 No registry credentials stored")
+				return
+			}
+
+			var registries []string
+			for _, credential := range credentials {
+				if !slices.Contains(registries, credential.Registry) {
+					registries = append(registries, credential.Registry)
+				}
+			}
+			prompt := &survey.Select{
+				Message: "Which registry do you want to log out of?",
+				Options: registries,
+			}
+			if err := survey.AskOne(prompt, &registry); err != nil {
+				return
+			}
+		}
+
+		if err := logoutRegistry(registry); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Printf("✅ Logged out of %s\n", getRegistryDisplayName(registry))
+	},
+}
+
 func runAuthWizard() {
 	fmt.Println("🔐 Docker Registry Authentication Wizard")
 	fmt.Println("========================================")
@@ -238,38 +294,44 @@ func checkAuthStatus() {
 	fmt.Println("\n🔍 Checking Docker authentication status...")
 	fmt.Println("==========================================")
 
-	// Check if user is logged in to Docker Hub
-	cmd := exec.Command("docker", "info")
-	output, err := cmd.CombinedOutput()
+	credentials, err := docker.StoredCredentials()
 	if err != nil {
-		fmt.Printf("❌ Failed to get Docker info: %v\n", err)
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
 		return
 	}
 
-	outputStr := string(output)
-	if strings.Contains(outputStr, "Username:") {
-		fmt.Println("✅ Authenticated with Docker Hub")
-	} else {
-		fmt.Println("❌ Not authenticated with Docker Hub")
+	path, _ := docker.DockerConfigPath()
+	if len(credentials) == 0 {
+		fmt.Printf("📭 No registry credentials stored in %s\n", path)
+		fmt.Println("\n💡 Tip: Use 'dockyard auth' to set up authentication for private registries.")
+		return
 	}
 
-	// Try to get registry auth info from Docker config
-	fmt.Println("\n📋 Checking configured registries...")
+	fmt.Printf("📋 Registries with stored credentials (%s):\n", path)
+	for _, credential := range credentials {
+		source := credential.Source
+		if credential.Helper != "" {
+			source = fmt.Sprintf("%s %s", credential.Source, credential.Helper)
+		}
+		fmt.Printf("✅ %-40s %s\n", getRegistryDisplayName(credential.Registry), source)
+	}
 
-	// This is a simple check - in practice, you might want to read ~/.docker/config.json
-	registries := []string{"registry.gitlab.com", "ghcr.io"}
+	fmt.Println("\n💡 Tip: Use 'dockyard auth logout [registry]' to remove stored credentials.")
+}
 
-	for _, registry := range registries {
-		cmd := exec.Command("docker", "login", registry, "--get-login")
-		_, err := cmd.CombinedOutput()
-		if err == nil {
-			fmt.Printf("✅ Configured: %s\n", registry)
-		} else {
-			fmt.Printf("❌ Not configured: %s\n", registry)
-		}
+// logoutRegistry removes the stored credentials of a registry with docker logout
+func logoutRegistry(registry string) error {
+	args := []string{"logout"}
+	if registry != docker.DefaultRegistry {
+		args = append(args, registry)
 	}
 
-	fmt.Println("\n💡 Tip: Use 'dockyard auth' to set up authentication for private registries.")
+	output, err := exec.Command(docker.CommandDocker, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("docker logout failed: %s", strings.TrimSpace(string(output)))
+	}
+	return nil
 }
 
 func getRegistryDisplayName(registryURL string) string {
@@ -278,7 +340,7 @@ func getRegistryDisplayName(registryURL string) string {
 		return "GitLab Container Registry"
 	case "ghcr.io":
 		return "GitHub Container Registry"
-	case "":
+	case "", docker.DefaultRegistry:
 		return "Docker Hub"
 	default:
 		return registryURL
@@ -300,5 +362,7 @@ func showGitHubInstructions() {
 }
 
 func init() {
+	authCmd.AddCommand(authStatusCmd)
+	authCmd.AddCommand(authLogoutCmd)
 	rootCmd.AddCommand(authCmd)
 }
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchRuns    int
+	benchTimeout time.Duration
+	benchForce   bool
+)
+
+var benchCmd = &cobra.Command{
+	Use:   "bench [project]",
+	Short: "Measure how long a project takes to start",
+	Long: `Take a project down and start it again, measuring the time until each service is up (healthy when it defines a healthcheck) and until the whole project is.
+With --runs the cycle is repeated and the average, fastest and slowest times are reported, to spot startup regressions of slow stacks.
+The containers are recreated, so the command asks for confirmation unless --force is given. Volumes are kept.`,
+	Example: `  dockyard bench myapp
+  dockyard bench myapp --runs 5`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		if benchRuns < 1 {
+			fmt.Println("❌ --runs must be at least 1")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if !benchForce && !confirmBench(projectName) {
+			fmt.Println("👍 Project was left untouched.")
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		applyProjectTimeout(cm, projectName)
+
+		var runs []*docker.BenchRun
+		for i := 1; i <= benchRuns; i++ {
+			fmt.Printf("⏱️  Run %d/%d: restarting %s...\n", i, benchRuns, projectName)
+			run, err := cm.BenchStartup(projectName, projectDir, benchTimeout)
+			if err != nil {
+				reportOperation(projectName, "bench", err)
+				fmt.Printf("❌ Run %d failed: %v\n", i, err)
+				setExitCodeForError(err)
+				if len(runs) > 0 {
+					displayBench(projectName, runs)
+				}
+				return
+			}
+			fmt.Printf("   ✅ Up in %s (down took %s)\n", formatBenchDuration(run.Total), formatBenchDuration(run.Down))
+			runs = append(runs, run)
+		}
+		reportOperation(projectName, "bench", nil)
+
+		displayBench(projectName, runs)
+	},
+}
+
+// confirmBench asks before the project is torn down and recreated
+func confirmBench(projectName string) bool {
+	confirmed := false
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("This takes %s down and recreates its containers %d time(s). Continue?", projectName, benchRuns),
+		Default: false,
+	}
+	if err := survey.AskOne(prompt, &confirmed); err != nil {
+		return false
+	}
+	return confirmed
+}
+
+// benchStats summarizes the timings of a service over the runs
+type benchStats struct {
+	service string
+	average time.Duration
+	fastest time.Duration
+	slowest time.Duration
+}
+
+// summarizeBench returns the timings of each service, fastest to start first
+func summarizeBench(runs []*docker.BenchRun) []benchStats {
+	durations := make(map[string][]time.Duration)
+	for _, run := range runs {
+		for service, ready := range run.Ready {
+			durations[service] = append(durations[service], ready)
+		}
+	}
+
+	stats := make([]benchStats, 0, len(durations))
+	for service, values := range durations {
+		stats = append(stats, durationStats(service, values))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].average != stats[j].average {
+			return stats[i].average < stats[j].average
+		}
+		return stats[i].service < stats[j].service
+	})
+	return stats
+}
+
+// durationStats returns the average, fastest and slowest of the durations
+func durationStats(name string, values []time.Duration) benchStats {
+	stats := benchStats{service: name, fastest: values[0], slowest: values[0]}
+	var sum time.Duration
+	for _, value := range values {
+		sum += value
+		stats.fastest = min(stats.fastest, value)
+		stats.slowest = max(stats.slowest, value)
+	}
+	stats.average = sum / time.Duration(len(values))
+	return stats
+}
+
+// displayBench prints the time to up of each service and of the whole project
+func displayBench(projectName string, runs []*docker.BenchRun) {
+	totals := make([]time.Duration, len(runs))
+	for i, run := range runs {
+		totals[i] = run.Total
+	}
+	total := durationStats(projectName, totals)
+
+	fmt.Printf("\n📊 Startup of project '%s' over %d run(s):\n", projectName, len(runs))
+	if len(runs) == 1 {
+		fmt.Printf("%-25s %s\n", "SERVICE", "UP AFTER")
+		fmt.Println(strings.Repeat("-", 40))
+		for _, stats := range summarizeBench(runs) {
+			fmt.Printf("%-25s %s\n", stats.service, formatBenchDuration(stats.average))
+		}
+		fmt.Println(strings.Repeat("-", 40))
+		fmt.Printf("%-25s %s\n", "total", formatBenchDuration(total.average))
+		return
+	}
+
+	fmt.Printf("%-25s %-10s %-10s %s\n", "SERVICE", "AVERAGE", "FASTEST", "SLOWEST")
+	fmt.Println(strings.Repeat("-", 60))
+	for _, stats := range summarizeBench(runs) {
+		fmt.Printf("%-25s %-10s %-10s %s\n", stats.service,
+			formatBenchDuration(stats.average), formatBenchDuration(stats.fastest), formatBenchDuration(stats.slowest))
+	}
+	fmt.Println(strings.Repeat("-", 60))
+	fmt.Printf("%-25s %-10s %-10s %s\n", "total",
+		formatBenchDuration(total.average), formatBenchDuration(total.fastest), formatBenchDuration(total.slowest))
+}
+
+// formatBenchDuration rounds a duration to a precision readable in a table
+func formatBenchDuration(d time.Duration) string {
+	return d.Round(10 * time.Millisecond).String()
+}
+
+func init() {
+	benchCmd.Flags().IntVar(&benchRuns, "runs", 1, "Number of down and up cycles to average over")
+	benchCmd.Flags().DurationVar(&benchTimeout, "timeout", docker.DefaultBenchTimeout, "Maximum time to wait for the services of each run to be up")
+	benchCmd.Flags().BoolVar(&benchForce, "force", false, "Skip the confirmation")
+	rootCmd.AddCommand(benchCmd)
+}
@@ -9,31 +9,40 @@ import (
 )
 
 var (
-	noCache bool
+	noCache       bool
+	buildProgress string
 )
 
 var buildCmd = &cobra.Command{
 	Use:   "build [project]",
 	Short: "Build images for a Docker project",
-	Long:  `Build or rebuild services in a Docker project`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Build or rebuild services in a Docker project.
+Use --progress plain for deterministic output in CI logs, or --progress json for machine-readable build events.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		if err := docker.ValidateBuildProgress(buildProgress); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
 			return
 		}
 
 		cm, err := docker.NewComposeManager()
 		if err != nil {
 			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
 			return
 		}
 		defer func(cm *docker.ComposeManager) {
@@ -45,9 +54,13 @@ var buildCmd = &cobra.Command{
 			}
 		}(cm)
 
-		err = cm.BuildImages(projectDir, noCache)
+		applyProjectTimeout(cm, projectName)
+
+		err = cm.BuildImages(projectDir, noCache, buildProgress)
+		reportOperation(projectName, "build", err)
 		if err != nil {
 			fmt.Printf("Failed to build project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
 			return
 		}
 	},
@@ -55,5 +68,6 @@ var buildCmd = &cobra.Command{
 
 func init() {
 	buildCmd.Flags().BoolVar(&noCache, "no-cache", false, "Do not use cache when building the image")
+	buildCmd.Flags().StringVar(&buildProgress, "progress", docker.BuildProgressAuto, "Build output format: auto, tty, plain or json")
 	rootCmd.AddCommand(buildCmd)
 }
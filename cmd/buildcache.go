@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pruneBuildCache bool
+	forcePrune      bool
+)
+
+var buildCacheCmd = &cobra.Command{
+	Use:   "build-cache [project]",
+	Short: "Report and prune the build cache of a project",
+	Long: `Show how much BuildKit build cache the RUN steps of a project's Dockerfiles use.
+Use --prune to remove the cache records that are not in use, --force to skip the confirmation.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		report, err := cm.GetProjectBuildCache(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get build cache for project %s: %v\n", projectName, err)
+			return
+		}
+
+		if !report.BuildKitInUse {
+			fmt.Println("ℹ️  No BuildKit build cache found. The legacy builder keeps no separate build cache, its layers are listed by 'dockyard orphans'.")
+			return
+		}
+
+		if len(report.Records) == 0 {
+			fmt.Printf("✨ No build cache found for project '%s'\n", projectName)
+			return
+		}
+
+		fmt.Printf("🏗️  Build cache for project '%s':\n", projectName)
+		fmt.Printf("   Records:     %d\n", len(report.Records))
+		fmt.Printf("   Total size:  %s\n", docker.FormatBytes(report.TotalSize))
+		fmt.Printf("   Reclaimable: %s\n", docker.FormatBytes(report.Reclaimable))
+
+		if !pruneBuildCache {
+			fmt.Printf("💡 Run 'dockyard build-cache %s --prune' to reclaim it\n", projectName)
+			return
+		}
+
+		ids := report.RecordIDs()
+		if len(ids) == 0 {
+			fmt.Println("ℹ️  All build cache records are in use, nothing to prune")
+			return
+		}
+
+		if !forcePrune {
+			var confirm bool
+			prompt := &survey.Confirm{
+				Message: fmt.Sprintf("Prune %d build cache record(s) (%s)?", len(ids), docker.FormatBytes(report.Reclaimable)),
+				Default: false,
+			}
+			if err := survey.AskOne(prompt, &confirm); err != nil || !confirm {
+				fmt.Println("👍 Build cache was kept.")
+				return
+			}
+		}
+
+		reclaimed, err := cm.PruneBuildCache(ids)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		fmt.Printf("🗑️  Reclaimed %s of build cache\n", docker.FormatBytes(int64(reclaimed)))
+	},
+}
+
+func init() {
+	buildCacheCmd.Flags().BoolVar(&pruneBuildCache, "prune", false, "Remove the project's build cache records that are not in use")
+	buildCacheCmd.Flags().BoolVar(&forcePrune, "force", false, "Prune without asking for confirmation")
+	rootCmd.AddCommand(buildCacheCmd)
+}
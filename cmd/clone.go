@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	clonePath      string
+	cloneCopyFiles bool
+)
+
+var cloneCmd = &cobra.Command{
+	Use:   "clone [source] [newname]",
+	Short: "Duplicate a project configuration",
+	Long: `Register a copy of an existing project's configuration under a new name.
+Only the dockyard configuration is duplicated unless --copy-files is given, in which case the project directory is copied to --path.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		sourceName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		newName := args[1]
+
+		if err := docker.CloneProject(sourceName, newName, clonePath, cloneCopyFiles); err != nil {
+			fmt.Printf("Failed to clone project %s: %v\n", sourceName, err)
+			return
+		}
+
+		fmt.Printf("✅ Successfully cloned project '%s' as '%s' (%s)\n", sourceName, newName, docker.Projects[newName])
+	},
+}
+
+func init() {
+	cloneCmd.Flags().StringVar(&clonePath, "path", "", "Path of the cloned project (defaults to the source project path)")
+	cloneCmd.Flags().BoolVar(&cloneCopyFiles, "copy-files", false, "Copy the source project directory to --path")
+	rootCmd.AddCommand(cloneCmd)
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var compareCmd = &cobra.Command{
+	Use:   "compare [projectA] [projectB]",
+	Short: "Compare the service definitions of two projects",
+	Long: `Diff the services of two projects (images, ports, environment keys and volumes) and print the differences.
+Lines prefixed with '-' only exist in projectA, lines prefixed with '+' only exist in projectB.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var projectNames [2]string
+		var projectDirs [2]string
+
+		for i, arg := range args {
+			projectName, ok := resolveProjectName(arg)
+			if !ok {
+				return
+			}
+			projectPath := docker.Projects[projectName]
+
+			projectDir, err := utils.ResolveHomeDir(projectPath)
+			if err != nil {
+				fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+				return
+			}
+
+			projectNames[i] = projectName
+			projectDirs[i] = projectDir
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		projectA, err := cm.LoadProject(projectDirs[0])
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectNames[0], err)
+			return
+		}
+
+		projectB, err := cm.LoadProject(projectDirs[1])
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectNames[1], err)
+			return
+		}
+
+		diffs := docker.CompareProjects(projectA, projectB)
+		if len(diffs) == 0 {
+			fmt.Printf("✅ Projects '%s' and '%s' have identical service definitions\n", projectNames[0], projectNames[1])
+			return
+		}
+
+		fmt.Printf("--- %s\n", projectNames[0])
+		fmt.Printf("+++ %s\n", projectNames[1])
+		displayServiceDiffs(projectNames[0], projectNames[1], diffs)
+	},
+}
+
+func displayServiceDiffs(nameA, nameB string, diffs []docker.ServiceDiff) {
+	for _, diff := range diffs {
+		fmt.Println()
+
+		switch {
+		case !diff.InB:
+			fmt.Printf("- service %s (only in %s)\n", diff.Service, nameA)
+			continue
+		case !diff.InA:
+			fmt.Printf("+ service %s (only in %s)\n", diff.Service, nameB)
+			continue
+		}
+
+		fmt.Printf("  service %s\n", diff.Service)
+		for _, field := range diff.Fields {
+			fmt.Printf("    %s:\n", field.Field)
+			for _, value := range field.OnlyA {
+				fmt.Printf("-     %s\n", value)
+			}
+			for _, value := range field.OnlyB {
+				fmt.Printf("+     %s\n", value)
+			}
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+}
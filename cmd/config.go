@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fixRemove = "Remove the project"
+	fixRepath = "Change its path"
+	fixRename = "Rename it"
+	fixSkip   = "Leave it as is"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and maintain the projects configuration",
+	// The doctor must be able to open a projects.json that fails to load
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+}
+
+var configDoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Validate projects.json and fix broken entries",
+	Long:  `Check projects.json for invalid entries, missing directories, missing compose files and projects registered twice, and offer to remove, repath or rename them.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		issues, err := docker.ValidateProjectsFile("projects.json")
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			fmt.Println("💡 Fix the JSON syntax by hand, dockyard cannot repair it automatically")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(issues) == 0 {
+			fmt.Printf("✅ projects.json is healthy (%d projects)\n", len(docker.Projects))
+			return
+		}
+
+		fmt.Printf("🩺 Found %d issue(s) in projects.json:\n", len(issues))
+		for _, issue := range issues {
+			fmt.Printf("   ❌ %s: %s (%s)\n", issue.Project, issue.Kind, issue.Detail)
+		}
+		fmt.Println()
+
+		actions, ok := fixProjectIssues(issues)
+		if !ok {
+			fmt.Println("👍 Interrupted, projects.json was left unchanged.")
+			setExitCode(ExitFailure)
+			return
+		}
+		if len(actions) == 0 {
+			fmt.Println("👍 No changes made.")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.SaveProjectsToFile("projects.json"); err != nil {
+			fmt.Printf("❌ Failed to save projects.json: %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Saved projects.json with %d change(s):", len(actions))))
+		for _, action := range actions {
+			fmt.Printf("   • %s\n", action)
+		}
+	},
+}
+
+// fixProjectIssues prompts for a fix for each affected project and returns the actions taken.
+// It returns false when a prompt is interrupted, the fixes chosen so far must then not be saved.
+func fixProjectIssues(issues []docker.ProjectIssue) ([]string, bool) {
+	var actions []string
+	handled := make(map[string]bool)
+
+	for _, issue := range issues {
+		if handled[issue.Project] {
+			continue
+		}
+		handled[issue.Project] = true
+
+		options := []string{fixRemove, fixRepath, fixRename, fixSkip}
+		if issue.Kind == docker.IssueInvalidEntry {
+			// Invalid entries are not loaded, so they can only be recreated with a new path
+			options = []string{fixRemove, fixRepath}
+		}
+
+		var fix string
+		prompt := &survey.Select{
+			Message: fmt.Sprintf("%s: %s. What would you like to do?", issue.Project, issue.Kind),
+			Options: options,
+		}
+		if err := survey.AskOne(prompt, &fix); err != nil {
+			return nil, false
+		}
+
+		switch fix {
+		case fixRemove:
+			delete(docker.Projects, issue.Project)
+			delete(docker.ProjectsSettings, issue.Project)
+			actions = append(actions, fmt.Sprintf("removed %s", issue.Project))
+
+		case fixRepath:
+			newPath, err := docker.BrowseForProjectPath()
+			if errors.Is(err, terminal.InterruptErr) {
+				return nil, false
+			}
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				continue
+			}
+			docker.Projects[issue.Project] = newPath
+			actions = append(actions, fmt.Sprintf("moved %s to %s", issue.Project, newPath))
+
+		case fixRename:
+			var newName string
+			if err := survey.AskOne(&survey.Input{Message: "New project name:"}, &newName); err != nil {
+				return nil, false
+			}
+			newName = strings.TrimSpace(newName)
+			if err := docker.RenameProject(issue.Project, newName); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				continue
+			}
+			actions = append(actions, fmt.Sprintf("renamed %s to %s", issue.Project, newName))
+		}
+	}
+
+	return actions, true
+}
+
+func init() {
+	configCmd.AddCommand(configDoctorCmd)
+	rootCmd.AddCommand(configCmd)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var contextCmd = &cobra.Command{
+	Use:   "context",
+	Short: "Manage the Docker context dockyard operates against",
+	Long:  `List and switch docker contexts (local, remote, colima, ...). Contexts are stored by docker itself, dockyard only wraps 'docker context'.`,
+}
+
+var contextListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Docker contexts",
+	Long:  `List all docker contexts and highlight the active one.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		contexts, err := docker.ListContexts()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		fmt.Println("Docker contexts:")
+		for _, ctx := range contexts {
+			marker := "  "
+			if ctx.Current {
+				marker = "👉"
+			}
+
+			fmt.Printf("%s %-20s %s\n", marker, ctx.Name, ctx.DockerEndpoint)
+			if ctx.Error != "" {
+				fmt.Printf("   ⚠️  %s\n", ctx.Error)
+			}
+		}
+	},
+}
+
+var contextUseCmd = &cobra.Command{
+	Use:   "use [name]",
+	Short: "Switch the active Docker context",
+	Long:  `Switch the active docker context. If no name is given, select one interactively.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var contextName string
+		if len(args) == 1 {
+			contextName = args[0]
+		} else {
+			contexts, err := docker.ListContexts()
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				return
+			}
+
+			var options []string
+			currentIndex := 0
+			for i, ctx := range contexts {
+				options = append(options, ctx.Name)
+				if ctx.Current {
+					currentIndex = i
+				}
+			}
+
+			prompt := &survey.Select{
+				Message: "Which context do you want to use?",
+				Options: options,
+				Default: currentIndex,
+			}
+			if err := survey.AskOne(prompt, &contextName); err != nil {
+				return
+			}
+		}
+
+		if err := docker.UseContext(contextName); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		fmt.Printf("✅ Now using docker context: %s\n", contextName)
+	},
+}
+
+func init() {
+	contextCmd.AddCommand(contextListCmd)
+	contextCmd.AddCommand(contextUseCmd)
+	rootCmd.AddCommand(contextCmd)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create [project]",
+	Short: "Create the containers of a Docker project without starting them",
+	Long:  `Create the containers of all services in a project without starting them, to inspect their configuration or debug entrypoint issues before anything runs. Start them later with 'dockyard start'.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		if err := cm.CreateProject(projectDir); err != nil {
+			fmt.Printf("Failed to create project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+		fmt.Printf("💡 Tip: Run 'dockyard status %s' to see the created containers\n", projectName)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(createCmd)
+}
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var daemonInfoCmd = &cobra.Command{
+	Use:   "daemon-info",
+	Short: "Show Docker daemon information and warnings",
+	Long:  `Display the Docker daemon version, system information, enabled features such as BuildKit, and the warnings the daemon reports (e.g. low disk space or a deprecated storage driver).`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := docker.ShowDaemonInfo(); err != nil {
+			fmt.Printf("❌ Failed to get daemon information: %v\n", err)
+			setExitCodeForError(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(daemonInfoCmd)
+}
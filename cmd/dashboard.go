@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// dashboardRefreshInterval is the delay between two status refreshes of the dashboard
+const dashboardRefreshInterval = 3 * time.Second
+
+var dashboardCmd = &cobra.Command{
+	Use:     "dashboard",
+	Aliases: []string{"tui"},
+	Short:   "Open an interactive dashboard of all projects",
+	Long: `Display a live, navigable list of all projects with their container status.
+Use the arrow keys to select a project, 's' to start, 'x' to stop, 'r' to restart, 'l' to view logs and 'q' to quit.
+Sending SIGHUP reloads projects.json.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		reloads, stopReloads := docker.NotifyProjectsReload()
+		defer stopReloads()
+
+		program := tea.NewProgram(newDashboardModel(cm, reloads), tea.WithAltScreen())
+		if _, err := program.Run(); err != nil {
+			fmt.Printf("❌ Dashboard failed: %v\n", err)
+			setExitCode(ExitFailure)
+		}
+	},
+}
+
+var (
+	dashboardTitleStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#00ADD8")).Bold(true)
+	dashboardSelectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#00ADD8")).Bold(true)
+	dashboardMutedStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#6B7280"))
+	dashboardErrorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#ED567A"))
+)
+
+// dashboardStatusMsg carries freshly fetched statuses for all projects
+type dashboardStatusMsg struct {
+	projects []string
+	results  []projectStatusResult
+}
+
+// dashboardTickMsg triggers a periodic status refresh
+type dashboardTickMsg struct{}
+
+// dashboardReloadMsg is sent when projects.json should be reloaded
+type dashboardReloadMsg struct{}
+
+// dashboardActionMsg reports the outcome of an action run on a project
+type dashboardActionMsg struct {
+	message string
+	err     error
+}
+
+type dashboardModel struct {
+	cm       *docker.ComposeManager
+	reloads  <-chan os.Signal
+	projects []string
+	results  []projectStatusResult
+	context  string
+	cursor   int
+	fetching bool
+	message  string
+	width    int
+	height   int
+}
+
+func newDashboardModel(cm *docker.ComposeManager, reloads <-chan os.Signal) dashboardModel {
+	return dashboardModel{
+		cm:       cm,
+		reloads:  reloads,
+		projects: docker.GetSortedProjectNames(),
+		context:  docker.CurrentContext(),
+		fetching: true,
+	}
+}
+
+func (m dashboardModel) Init() tea.Cmd {
+	return tea.Batch(m.fetchStatuses(), dashboardTick(), m.waitForReload())
+}
+
+func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case dashboardStatusMsg:
+		m.projects, m.results = msg.projects, msg.results
+		m.fetching = false
+		m.cursor = min(m.cursor, max(len(m.projects)-1, 0))
+		return m, nil
+
+	case dashboardTickMsg:
+		if m.fetching {
+			return m, dashboardTick()
+		}
+		m.fetching = true
+		return m, tea.Batch(m.fetchStatuses(), dashboardTick())
+
+	case dashboardReloadMsg:
+		if err := docker.ReloadProjectsFromFile("projects.json"); err != nil {
+			m.message = fmt.Sprintf("❌ Failed to reload projects.json: %v", err)
+		} else {
+			m.message = "🔄 Reloaded projects.json"
+		}
+		return m, tea.Batch(m.fetchStatuses(), m.waitForReload())
+
+	case dashboardActionMsg:
+		m.message = msg.message
+		if msg.err != nil {
+			m.message = fmt.Sprintf("❌ %v", msg.err)
+		}
+		return m, m.fetchStatuses()
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m dashboardModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc", "ctrl+c":
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.projects)-1 {
+			m.cursor++
+		}
+	case "s":
+		return m, m.runAction("started", func(cm *docker.ComposeManager, projectName, projectDir string) error {
+			return cm.StartProject(projectDir, docker.StartOptions{
+				Detached:      true,
+				RemoveOrphans: true,
+				Scale:         docker.ProjectsSettings[projectName].Scale,
+			})
+		})
+	case "x":
+		return m, m.runAction("stopped", func(cm *docker.ComposeManager, _, projectDir string) error {
+			return cm.StopProject(projectDir, false, false)
+		})
+	case "r":
+		return m, m.runAction("restarted", func(cm *docker.ComposeManager, _, projectDir string) error {
+			return cm.RestartProject(projectDir, docker.RestartOptions{})
+		})
+	case "l":
+		return m, m.viewLogs()
+	}
+	return m, nil
+}
+
+func (m dashboardModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(dashboardTitleStyle.Render("🐳 Dockyard Dashboard"))
+	if m.context != "" {
+		b.WriteString(dashboardMutedStyle.Render(fmt.Sprintf("  context: %s", m.context)))
+	}
+	b.WriteString("\n\n")
+
+	if len(m.projects) == 0 {
+		b.WriteString("📭 No projects configured. Run 'dockyard manage' to add one.\n")
+	}
+
+	// Keep the selected project visible when the terminal is shorter than the list
+	visible := len(m.projects)
+	if m.height > 0 {
+		visible = max(m.height-6, 1)
+	}
+	offset := max(m.cursor-visible+1, 0)
+
+	for i := offset; i < len(m.projects) && i < offset+visible; i++ {
+		line := fmt.Sprintf("%-25s %s", m.projects[i], m.projectSummary(i))
+		if m.width > 2 {
+			line = lipgloss.NewStyle().MaxWidth(m.width - 2).Render(line)
+		}
+
+		if i == m.cursor {
+			b.WriteString(dashboardSelectedStyle.Render("▶ " + line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if m.message != "" {
+		b.WriteString(m.message + "\n")
+	}
+	b.WriteString(dashboardMutedStyle.Render("↑/↓ select • s start • x stop • r restart • l logs (Ctrl+C to return) • q quit"))
+
+	return b.String()
+}
+
+// projectSummary renders the status column of the project at index i
+func (m dashboardModel) projectSummary(i int) string {
+	if i >= len(m.results) {
+		return dashboardMutedStyle.Render("loading...")
+	}
+
+	result := m.results[i]
+	if result.failure != "" {
+		return dashboardErrorStyle.Render("❌ " + result.failure)
+	}
+	if len(result.statuses) == 0 {
+		return "📭 no containers"
+	}
+
+	running := countRunningContainers(result.statuses)
+	emoji := "⏹️ "
+	if running > 0 {
+		emoji = "🟢"
+	}
+	return fmt.Sprintf("%s %d/%d containers running", emoji, running, len(result.statuses))
+}
+
+// selectedProject returns the name and directory of the selected project
+func (m dashboardModel) selectedProject() (string, string, error) {
+	if len(m.projects) == 0 {
+		return "", "", fmt.Errorf("no project selected")
+	}
+
+	projectName := m.projects[m.cursor]
+	projectDir, err := utils.ResolveHomeDir(docker.Projects[projectName])
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve home directory for %s: %v", projectName, err)
+	}
+	return projectName, projectDir, nil
+}
+
+// fetchStatuses queries every project in the background. Paths are captured up front since
+// projects.json may be reloaded while the query runs.
+func (m dashboardModel) fetchStatuses() tea.Cmd {
+	cm := m.cm
+	projects := docker.GetSortedProjectNames()
+	paths := make([]string, len(projects))
+	for i, projectName := range projects {
+		paths[i] = docker.Projects[projectName]
+	}
+
+	return func() tea.Msg {
+		results := make([]projectStatusResult, len(projects))
+		for i, projectPath := range paths {
+			results[i] = fetchProjectStatus(cm, projectPath)
+		}
+		return dashboardStatusMsg{projects: projects, results: results}
+	}
+}
+
+func dashboardTick() tea.Cmd {
+	return tea.Tick(dashboardRefreshInterval, func(time.Time) tea.Msg { return dashboardTickMsg{} })
+}
+
+func (m dashboardModel) waitForReload() tea.Cmd {
+	reloads := m.reloads
+	return func() tea.Msg {
+		<-reloads
+		return dashboardReloadMsg{}
+	}
+}
+
+// runAction suspends the dashboard while a compose operation runs on the selected project,
+// so its regular output is visible, and reports the outcome once the dashboard is back
+func (m dashboardModel) runAction(verb string, action func(cm *docker.ComposeManager, projectName, projectDir string) error) tea.Cmd {
+	projectName, projectDir, err := m.selectedProject()
+	if err != nil {
+		return func() tea.Msg { return dashboardActionMsg{err: err} }
+	}
+
+	cm := m.cm
+	run := &dashboardExec{run: func() error { return action(cm, projectName, projectDir) }}
+	return tea.Exec(run, func(err error) tea.Msg {
+		if err != nil {
+			return dashboardActionMsg{err: fmt.Errorf("%s: %v", projectName, err)}
+		}
+		return dashboardActionMsg{message: fmt.Sprintf("✅ Project %s %s", projectName, verb)}
+	})
+}
+
+// viewLogs suspends the dashboard and follows the logs of the selected project until Ctrl+C
+func (m dashboardModel) viewLogs() tea.Cmd {
+	projectName, projectDir, err := m.selectedProject()
+	if err != nil {
+		return func() tea.Msg { return dashboardActionMsg{err: err} }
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return func() tea.Msg { return dashboardActionMsg{err: err} }
+	}
+
+	logs := exec.Command(docker.CommandDocker, utils.ComposeArgs(composeFilePath, "logs", "-f", "--tail", "100")...)
+	logs.Dir = projectDir
+	return tea.ExecProcess(logs, func(error) tea.Msg {
+		return dashboardActionMsg{message: fmt.Sprintf("📋 Closed logs of %s", projectName)}
+	})
+}
+
+// dashboardExec adapts a function to tea.ExecCommand so it runs with the terminal released
+type dashboardExec struct {
+	run func() error
+}
+
+func (e *dashboardExec) Run() error {
+	err := e.run()
+	fmt.Println("\nPress Enter to return to the dashboard")
+	fmt.Scanln()
+	return err
+}
+
+func (e *dashboardExec) SetStdin(io.Reader)  {}
+func (e *dashboardExec) SetStdout(io.Writer) {}
+func (e *dashboardExec) SetStderr(io.Writer) {}
+
+func init() {
+	rootCmd.AddCommand(dashboardCmd)
+}
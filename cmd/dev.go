@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var devCmd = &cobra.Command{
+	Use:   "dev [project]",
+	Short: "Run a Docker project in develop (watch) mode",
+	Long:  `Run docker compose watch for a project, syncing files and rebuilding services as they change until interrupted. Requires a develop.watch section in the compose file.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		if err := cm.WatchProject(projectDir); err != nil {
+			fmt.Printf("Failed to watch project %s: %v\n", projectName, err)
+			return
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(devCmd)
+}
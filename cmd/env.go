@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+var envCmd = &cobra.Command{
+	Use:   "env [project] [service...]",
+	Short: "List the environment of a project's services",
+	Long: `Display the resolved environment variables of each service in a project.
+Values of keys matching PASSWORD, TOKEN, SECRET or KEY are replaced with ****.
+Set DOCKYARD_SECRET_KEYS to a comma-separated list to use your own key patterns.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			return
+		}
+
+		services, err := project.GetServices(args[1:]...)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		redactor := utils.NewRedactor(docker.ProjectEnvironment(project))
+
+		fmt.Printf("🌱 Environment for project '%s':\n", projectName)
+		for _, service := range services {
+			fmt.Printf("\n%s:\n", service.Name)
+
+			if len(service.Environment) == 0 {
+				fmt.Println("   (no environment variables)")
+				continue
+			}
+
+			keys := make([]string, 0, len(service.Environment))
+			for key := range service.Environment {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				value := service.Environment[key]
+				if value == nil {
+					fmt.Printf("   %s (unset)\n", key)
+					continue
+				}
+				fmt.Printf("   %s=%s\n", key, redactor.Redact(redactor.RedactValue(key, *value)))
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(envCmd)
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var envDiffCmd = &cobra.Command{
+	Use:   "env-diff [project]",
+	Short: "Compare the variables a compose file uses with its .env file",
+	Long: `List the variables interpolated by a project's compose file that are neither set in .env nor in
+the environment, and the .env entries that the compose file never references.
+Missing variables close to an unused .env entry are reported as likely typos.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		diff, err := docker.CompareEnvFile(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Printf("🌱 Variables of project '%s': %d referenced, %d unused in .env\n\n",
+			projectName, len(diff.Referenced), len(diff.Unused))
+
+		missing := diff.Missing()
+		fmt.Printf("Missing (%d):\n", len(missing))
+		if len(missing) == 0 {
+			fmt.Println("   ✅ Every referenced variable is set or has a default")
+		}
+		for _, ref := range missing {
+			fmt.Printf("   ❌ %s", ref.Name)
+			if ref.Suggestion != "" {
+				fmt.Printf(" (did you mean %s?)", ref.Suggestion)
+			}
+			fmt.Println()
+		}
+		fmt.Println()
+
+		fmt.Printf("Unused (%d):\n", len(diff.Unused))
+		switch {
+		case len(diff.EnvFileServices) > 0:
+			fmt.Printf("   ✅ Every .env entry is loaded through env_file by %s\n", strings.Join(diff.EnvFileServices, ", "))
+		case len(diff.Unused) == 0:
+			fmt.Println("   ✅ Every .env entry is referenced by the compose file")
+		}
+		for _, name := range diff.Unused {
+			fmt.Printf("   ⚪ %s\n", name)
+		}
+
+		if len(missing) > 0 {
+			setExitCode(ExitFailure)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(envDiffCmd)
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var envInitCmd = &cobra.Command{
+	Use:   "init [project]",
+	Short: "Generate a .env.example from the variables the compose file references",
+	Long:  `Scan a project's compose file for interpolated variables and write them with empty values to .env.example, asking before overwriting an existing one.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, projectDir, ok := resolveEnvProject(args[0])
+		if !ok {
+			return
+		}
+
+		content, err := docker.GenerateEnvExample(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		templatePath := filepath.Join(projectDir, docker.EnvExampleFile)
+		if _, err := os.Stat(templatePath); err == nil {
+			overwrite := false
+			prompt := &survey.Confirm{
+				Message: fmt.Sprintf("%s already exists. Overwrite it?", templatePath),
+				Default: false,
+			}
+			if err := survey.AskOne(prompt, &overwrite); err != nil || !overwrite {
+				fmt.Println("👍 Kept the existing template.")
+				return
+			}
+		}
+
+		if err := os.WriteFile(templatePath, []byte(content), 0644); err != nil {
+			fmt.Printf("❌ Failed to write %s: %v\n", templatePath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Wrote %s for project %s", templatePath, projectName)))
+	},
+}
+
+var envCheckCmd = &cobra.Command{
+	Use:   "check [project]",
+	Short: "Compare a project's .env with its .env.example",
+	Long: `Report the variables of .env.example that are missing or empty in .env, and the .env variables the template does not mention.
+Missing variables with a default in the compose file, such as ${VAR:-x}, are only reported and do not fail the check.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, projectDir, ok := resolveEnvProject(args[0])
+		if !ok {
+			return
+		}
+
+		check, err := docker.CheckEnvTemplate(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			fmt.Printf("💡 Tip: Run 'dockyard env init %s' to generate one\n", projectName)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Printf("🌱 .env of project '%s' compared with %s:\n\n", projectName, docker.EnvExampleFile)
+		for _, name := range check.Missing {
+			fmt.Printf("   ❌ %s is missing\n", name)
+		}
+		for _, name := range check.Empty {
+			fmt.Printf("   ⚠️  %s is empty\n", name)
+		}
+		for _, name := range check.Defaulted {
+			fmt.Printf("   ⚪ %s is not set, the compose file default is used\n", name)
+		}
+		for _, name := range check.Extra {
+			fmt.Printf("   ⚪ %s is not in the template\n", name)
+		}
+
+		if len(check.Missing)+len(check.Empty)+len(check.Defaulted)+len(check.Extra) == 0 {
+			fmt.Println(ui.RenderSuccess(".env matches the template"))
+			return
+		}
+		if len(check.Missing) > 0 {
+			setExitCode(ExitFailure)
+		}
+	},
+}
+
+// resolveEnvProject returns the name and directory of the project given to an env subcommand
+func resolveEnvProject(name string) (string, string, bool) {
+	projectName, ok := resolveProjectName(name)
+	if !ok {
+		return "", "", false
+	}
+	projectPath := docker.Projects[projectName]
+
+	projectDir, err := utils.ResolveHomeDir(projectPath)
+	if err != nil {
+		fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+		setExitCode(ExitFailure)
+		return "", "", false
+	}
+	return projectName, projectDir, true
+}
+
+func init() {
+	envCmd.AddCommand(envInitCmd)
+	envCmd.AddCommand(envCheckCmd)
+}
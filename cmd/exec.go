@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	execUser       string
+	execWorkDir    string
+	execDetachKeys string
+)
+
+var execCmd = &cobra.Command{
+	Use:   "exec [project] [service] [command...]",
+	Short: "Run a command in a running service container",
+	Long: `Run a command, sh by default, in the first running container of a service.
+On an interactive terminal the command gets a TTY sized like your terminal, which follows it when
+the window is resized, and the session can be left running with the detach keys (Ctrl-P Ctrl-Q
+unless --detach-keys is given). When input or output is not a terminal, docker compose exec
+is used without a TTY. Flags after the service are passed to the command.`,
+	Example: `  dockyard exec myapp web
+  dockyard exec myapp db psql -U postgres
+  dockyard exec -u root myapp web ls -la /var/log`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+		service := args[1]
+
+		if err := docker.ValidateDetachKeys(execDetachKeys); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		result, err := cm.ExecInService(projectDir, service, docker.ExecOptions{
+			Command:    args[2:],
+			User:       execUser,
+			WorkDir:    execWorkDir,
+			DetachKeys: execDetachKeys,
+		})
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if result.Detached {
+			fmt.Printf("\n🔌 Detached, the command keeps running in %s\n", service)
+			return
+		}
+		// Report the exit status of the command like docker exec does
+		setExitCode(result.ExitCode)
+	},
+}
+
+func init() {
+	execCmd.Flags().SetInterspersed(false)
+	execCmd.Flags().StringVarP(&execUser, "user", "u", "", "User to run the command as")
+	execCmd.Flags().StringVarP(&execWorkDir, "workdir", "w", "", "Working directory of the command")
+	execCmd.Flags().StringVar(&execDetachKeys, "detach-keys", docker.DefaultDetachKeys, "Key sequence for detaching from the command")
+	rootCmd.AddCommand(execCmd)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"errors"
+)
+
+// Exit codes reported by dockyard commands so scripts can branch on the failure
+const (
+	ExitSuccess           = 0 // The operation succeeded
+	ExitFailure           = 1 // Generic failure
+	ExitDaemonUnavailable = 2 // The Docker daemon could not be reached
+	ExitProjectNotFound   = 3 // The project is not registered in projects.json
+	ExitAuthRequired      = 4 // Registry authentication is required
+	ExitTimeout           = 5 // The operation exceeded its timeout and was killed
+)
+
+// exitCode is the process exit code returned by Execute once the command finishes
+var exitCode = ExitSuccess
+
+// setExitCode records the exit code of the current command
+func setExitCode(code int) {
+	exitCode = code
+}
+
+// setExitCodeForError records the exit code matching an operation error
+func setExitCodeForError(err error) {
+	setExitCode(exitCodeForError(err))
+}
+
+// exitCodeForError maps an operation error to its documented exit code
+func exitCodeForError(err error) int {
+	switch {
+	case err == nil:
+		return ExitSuccess
+	case errors.Is(err, docker.ErrAuthRequired):
+		return ExitAuthRequired
+	case errors.Is(err, docker.ErrOperationTimeout):
+		return ExitTimeout
+	case isDaemonError(err):
+		return ExitDaemonUnavailable
+	default:
+		return ExitFailure
+	}
+}
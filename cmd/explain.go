@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	explainOptions        docker.ExplainOptions
+	explainRestartTimeout time.Duration
+)
+
+var explainCmd = &cobra.Command{
+	Use:   "explain [project] [operation] [service...]",
+	Short: "Print the docker compose command an operation would run",
+	Long: `Print the exact docker compose command, with resolved file paths and flags, that an operation would execute for a project, without running it.
+Operations: up, down, restart, build, pull and logs. The flags mirror those of the matching dockyard commands, and up uses the scale defaults of projects.json like 'dockyard start'.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+		operation := args[1]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		options := explainOptions
+		options.Start.Services = args[2:]
+		options.Start.Scale = docker.ProjectsSettings[projectName].Scale
+		options.Restart.NoDeps = options.Start.NoDeps
+		if cmd.Flags().Changed("timeout") {
+			options.Restart.Timeout = &explainRestartTimeout
+		}
+
+		command, err := cm.ExplainOperation(projectDir, operation, options)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Printf("📂 Working directory: %s\n", projectDir)
+		fmt.Println(docker.ShellQuote(command))
+	},
+}
+
+func init() {
+	explainCmd.Flags().BoolVarP(&explainOptions.Start.Detached, "detach", "d", true, "up: run containers in the background")
+	explainCmd.Flags().BoolVar(&explainOptions.Start.RemoveOrphans, "remove-orphans", true, "up: remove containers for services not defined in the Compose file")
+	explainCmd.Flags().BoolVar(&explainOptions.Start.NoDeps, "no-deps", false, "up, restart: leave out the dependencies or dependents of the given services")
+	explainCmd.Flags().BoolVar(&explainOptions.Start.Offline, "offline", false, "up: never pull images")
+	explainCmd.Flags().DurationVar(&explainRestartTimeout, "timeout", 0, "restart: grace period before stopping containers are killed")
+	explainCmd.Flags().BoolVarP(&explainOptions.RemoveVolumes, "volumes", "v", false, "down: remove volumes")
+	explainCmd.Flags().BoolVar(&explainOptions.RemoveImages, "rmi", false, "down: remove images used by services")
+	explainCmd.Flags().BoolVar(&explainOptions.NoBuildCache, "no-cache", false, "build: do not use cache when building images")
+	explainCmd.Flags().StringVar(&explainOptions.BuildProgress, "progress", docker.BuildProgressAuto, "build: progress output type")
+	explainCmd.Flags().BoolVarP(&explainOptions.Follow, "follow", "f", false, "logs: follow log output")
+	rootCmd.AddCommand(explainCmd)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var extensionsCmd = &cobra.Command{
+	Use:   "extensions [project]",
+	Short: "Show the x- extension fields and YAML anchors of a compose file",
+	Long: `List the x- extension fields of a project's compose file, at the top level and nested in services or other sections, with their values as written, and the YAML anchors it defines with the places referencing them.
+These are often used for shared configuration fragments and tooling settings. The raw compose file is read, since loading it resolves anchors and drops x- fields. Files pulled in with include are not read.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		extensions, err := docker.ReadComposeExtensions(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(extensions.Extensions) == 0 && len(extensions.Anchors) == 0 {
+			fmt.Printf("📭 %s defines no x- extension fields or anchors\n", extensions.File)
+			return
+		}
+
+		fmt.Printf("🧩 Extensions of project '%s' (%s):\n", projectName, extensions.File)
+		displayExtensions(extensions.Extensions)
+		displayAnchors(extensions.Anchors)
+	},
+}
+
+// displayExtensions prints each x- field with its value indented below it
+func displayExtensions(extensions []docker.ComposeExtension) {
+	if len(extensions) == 0 {
+		return
+	}
+
+	fmt.Printf("\n📦 x- fields (%d):\n", len(extensions))
+	for _, extension := range extensions {
+		header := fmt.Sprintf("%s (line %d)", extension.Path, extension.Line)
+		if extension.Anchor != "" {
+			header += " &" + extension.Anchor
+		}
+		fmt.Printf("\n  %s\n", header)
+		for _, line := range strings.Split(extension.Value, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+}
+
+// displayAnchors prints each anchor with where it is defined and referenced
+func displayAnchors(anchors []docker.ComposeAnchor) {
+	if len(anchors) == 0 {
+		return
+	}
+
+	fmt.Printf("\n⚓ Anchors (%d):\n", len(anchors))
+	for _, anchor := range anchors {
+		line := fmt.Sprintf("  &%s at %s (line %d)", anchor.Name, anchor.Path, anchor.Line)
+		if len(anchor.Keys) > 0 {
+			line += fmt.Sprintf(" — %s", strings.Join(anchor.Keys, ", "))
+		}
+		fmt.Println(line)
+
+		if len(anchor.UsedBy) == 0 {
+			fmt.Printf("    %s\n", ui.RenderWarning("not referenced"))
+			continue
+		}
+		for _, usage := range anchor.UsedBy {
+			fmt.Printf("    ← %s\n", usage)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(extensionsCmd)
+}
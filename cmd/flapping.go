@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	flapWindow    time.Duration
+	flapThreshold int
+)
+
+var flappingCmd = &cobra.Command{
+	Use:   "flapping [project]",
+	Short: "Show container restart counts and detect crash loops",
+	Long: `Report how often each container of a project was restarted and why it last stopped. Containers that stopped
+at least --threshold times within the last --window, according to the docker events, are flagged as flapping
+and listed first. The daemon only keeps a limited number of recent events.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if flapWindow <= 0 || flapThreshold < 1 {
+			fmt.Println("The --window flag must be a positive duration and --threshold at least 1")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		history, err := cm.ContainerRestartHistory(project.Name, flapWindow, flapThreshold)
+		if err != nil {
+			fmt.Printf("❌ Failed to get restart history of project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(history) == 0 {
+			fmt.Printf("📭 No containers found for project '%s'\n", projectName)
+			return
+		}
+
+		displayRestartHistory(projectName, history)
+	},
+}
+
+// displayRestartHistory prints the restart history of the containers and suggests logs for flapping ones
+func displayRestartHistory(projectName string, history []docker.ContainerRestarts) {
+	fmt.Printf("🔁 Restart history of '%s' (window %s, threshold %d):\n", projectName, flapWindow, flapThreshold)
+	fmt.Printf("   %-3s %-30s %-10s %8s %8s  %s\n", "", "CONTAINER", "STATE", "RESTARTS", "RECENT", "LAST EXIT")
+
+	var flapping []string
+	for _, restarts := range history {
+		icon := "🟢"
+		switch {
+		case restarts.Flapping:
+			icon = "🔴"
+			if !slices.Contains(flapping, restarts.Service) {
+				flapping = append(flapping, restarts.Service)
+			}
+		case restarts.RestartCount > 0 || restarts.RecentDeaths > 0:
+			icon = "🟡"
+		}
+
+		lastExit := restarts.LastExit
+		if lastExit == "" {
+			lastExit = "-"
+		}
+		fmt.Printf("   %s %-30s %-10s %8d %8d  %s\n", icon, restarts.Name, restarts.State,
+			restarts.RestartCount, restarts.RecentDeaths, lastExit)
+	}
+	fmt.Println()
+
+	if len(flapping) == 0 {
+		fmt.Println("✅ No container is flapping")
+		return
+	}
+
+	fmt.Printf("⚠️  %d service(s) are flapping\n", len(flapping))
+	for _, service := range flapping {
+		fmt.Printf("💡 Run 'dockyard logs %s %s' to see why %s keeps stopping\n", projectName, service, service)
+	}
+}
+
+func init() {
+	flappingCmd.Flags().DurationVar(&flapWindow, "window", docker.DefaultFlapWindow, "Window recent restarts are counted in")
+	flappingCmd.Flags().IntVar(&flapThreshold, "threshold", docker.DefaultFlapThreshold, "Number of restarts within the window that makes a container flap")
+	rootCmd.AddCommand(flappingCmd)
+}
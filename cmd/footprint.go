@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var footprintCmd = &cobra.Command{
+	Use:   "footprint",
+	Short: "Show the total resources used by running projects",
+	Long: `Sum the CPU and memory usage of every running compose container, per project and in total, along with the disk used by the images of those containers and the volumes of their projects.
+Projects are listed heaviest first by memory, to help decide what to shut down to reclaim resources. CPU is shown as a percentage of a single core.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		fmt.Println("🔍 Sampling running containers and disk usage...")
+		footprint, err := cm.ComputeFootprint()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(footprint.Projects) == 0 {
+			fmt.Println("📭 No compose containers are running")
+			return
+		}
+
+		displayFootprint(footprint)
+	},
+}
+
+// displayFootprint prints the per-project breakdown followed by the totals
+func displayFootprint(footprint *docker.Footprint) {
+	fmt.Printf("\n%-25s %6s %8s %10s %10s %10s\n", "PROJECT", "CTRS", "CPU", "MEMORY", "IMAGES", "VOLUMES")
+	for _, project := range footprint.Projects {
+		name := project.Project
+		if !project.Registered {
+			name += " (unregistered)"
+		}
+		fmt.Printf("%-25s %6d %7.1f%% %10s %10s %10s\n",
+			name,
+			project.Containers,
+			project.CPUPercent,
+			docker.FormatBytes(project.MemoryUsage),
+			docker.FormatBytes(project.ImageSize),
+			docker.FormatBytes(project.VolumeSize))
+	}
+
+	fmt.Println()
+	fmt.Printf("📊 Total: %.1f%% CPU", footprint.CPUPercent)
+	if footprint.NCPU > 0 {
+		fmt.Printf(" (%.1f%% of %d cores)", footprint.CPUPercent/float64(footprint.NCPU), footprint.NCPU)
+	}
+	fmt.Printf(", %s memory", docker.FormatBytes(footprint.MemoryUsage))
+	if footprint.MemTotal > 0 {
+		fmt.Printf(" (%.1f%% of %s)", float64(footprint.MemoryUsage)/float64(footprint.MemTotal)*100, docker.FormatBytes(footprint.MemTotal))
+	}
+	fmt.Println()
+	fmt.Printf("💾 Disk: %s in images, %s in volumes\n", docker.FormatBytes(footprint.ImageSize), docker.FormatBytes(footprint.VolumeSize))
+}
+
+func init() {
+	rootCmd.AddCommand(footprintCmd)
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var graphFormat string
+
+var graphCmd = &cobra.Command{
+	Use:   "graph [project]",
+	Short: "Print the service and network topology of a project",
+	Long: `Describe the services of a project, the networks they join and their depends_on edges as a Graphviz DOT or Mermaid graph, annotated with published ports.
+Pipe the output to a renderer, e.g. 'dockyard graph myproject | dot -Tsvg > stack.svg'.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		graph, err := docker.RenderTopology(project, graphFormat)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Print(graph)
+	},
+}
+
+func init() {
+	graphCmd.Flags().StringVar(&graphFormat, "format", docker.GraphFormatDot, "Output format: dot or mermaid")
+	rootCmd.AddCommand(graphCmd)
+}
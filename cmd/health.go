@@ -4,6 +4,7 @@ import (
 	"dockyard/pkg/docker"
 	"dockyard/pkg/utils"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,27 +12,60 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	healthCheck      bool
+	healthMinHealthy int
+)
+
 var healthCmd = &cobra.Command{
 	Use:   "health [project]",
 	Short: "Check and fix project health issues",
-	Long:  `Analyze project container health and offer solutions for common issues like stopped containers.`,
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Analyze project container health and offer solutions for common issues like stopped containers.
+Use --check to only print a one-line summary per project, without any prompt, and exit with a non-zero
+status when a project is not healthy, e.g. from cron or a monitoring probe. With --min-healthy N, a
+project passes when at least N of its services are up, counting healthchecks and one-shot services
+that completed.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		if healthMinHealthy < 0 {
+			fmt.Println("The --min-healthy flag cannot be negative")
+			setExitCode(ExitFailure)
+			return
+		}
+		if healthMinHealthy > 0 && !healthCheck {
+			fmt.Println("The --min-healthy flag requires --check")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if healthCheck {
+			projectNames := docker.GetSortedProjectNames()
+			if len(args) == 1 {
+				projectName, ok := resolveProjectName(args[0])
+				if !ok {
+					return
+				}
+				projectNames = []string{projectName}
+			}
+			probeProjectsHealth(projectNames, healthMinHealthy)
+			return
+		}
+
 		if len(args) == 0 {
 			checkAllProjectsHealth()
 			return
 		}
 
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
 			return
 		}
 
@@ -47,6 +81,7 @@ func checkAllProjectsHealth() {
 	// Check Docker status first
 	if err := docker.CheckDockerStatus(); err != nil {
 		fmt.Printf("❌ Docker status check failed: %v\n", err)
+		setExitCodeForError(err)
 		return
 	}
 
@@ -107,6 +142,7 @@ func checkProjectHealth(projectName, projectDir string) {
 	cm, err := docker.NewComposeManager()
 	if err != nil {
 		fmt.Printf("❌ Failed to create compose manager: %v\n", err)
+		setExitCodeForError(err)
 		return
 	}
 	defer cm.Close()
@@ -114,6 +150,7 @@ func checkProjectHealth(projectName, projectDir string) {
 	statuses, err := cm.GetProjectStatus(projectDir)
 	if err != nil {
 		fmt.Printf("❌ Failed to get project status: %v\n", err)
+		setExitCodeForError(err)
 		return
 	}
 
@@ -123,17 +160,37 @@ func checkProjectHealth(projectName, projectDir string) {
 		return
 	}
 
-	// Analyze container health
+	// Analyze container health. Readiness covers healthchecks and one-shot services, so a
+	// running but unhealthy container is offered for repair and a completed one is not.
+	readiness := projectServiceReadiness(cm, projectDir)
 	runningCount := 0
 	stoppedCount := 0
 	errorCount := 0
 	var issues []string
+	var unhealthyServices []string
 
 	for _, status := range statuses {
-		switch status.State {
-		case "running":
+		state, known := readiness[status.Service]
+		ready := status.State == "running"
+		if known {
+			ready = docker.IsReadyState(state)
+		}
+		if !ready && !slices.Contains(unhealthyServices, status.Service) {
+			unhealthyServices = append(unhealthyServices, status.Service)
+		}
+
+		switch {
+		case status.State == "running" && known && state == docker.ReadinessUnhealthy:
+			runningCount++
+			issues = append(issues, fmt.Sprintf("🩺 %s: running but its healthcheck fails (%s)", status.Service, status.Status))
+		case status.State == "running" && known && state == docker.ReadinessStarting:
 			runningCount++
-		case "exited":
+			issues = append(issues, fmt.Sprintf("⏳ %s: running, healthcheck not passing yet (%s)", status.Service, status.Status))
+		case status.State == "running":
+			runningCount++
+		case state == docker.ReadinessCompleted:
+			// A one-shot service that exited successfully is done, not stopped
+		case status.State == "exited":
 			stoppedCount++
 			if strings.Contains(status.Status, "Exited (1)") ||
 				strings.Contains(status.Status, "Exited (125)") ||
@@ -149,8 +206,8 @@ func checkProjectHealth(projectName, projectDir string) {
 	}
 
 	// Report health status
-	if runningCount == len(statuses) {
-		fmt.Println("✅ Project is healthy - all containers are running!")
+	if len(unhealthyServices) == 0 {
+		fmt.Println("✅ Project is healthy - all services are up!")
 		return
 	}
 
@@ -167,38 +224,99 @@ func checkProjectHealth(projectName, projectDir string) {
 	}
 
 	// Offer solutions
-	offerHealthSolutions(projectName, projectDir, errorCount > 0, stoppedCount > 0)
+	offerHealthSolutions(projectName, projectDir, errorCount > 0, stoppedCount > 0, unhealthyServices)
+}
+
+// projectServiceReadiness returns the readiness state of each service, or nil when it cannot be
+// determined and the container states have to do
+func projectServiceReadiness(cm *docker.ComposeManager, projectDir string) map[string]string {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil
+	}
+	states, err := cm.GetServiceReadiness(project)
+	if err != nil {
+		return nil
+	}
+	return states
 }
 
 func checkProjectHealthQuiet(projectName, projectDir string) bool {
+	ready, total, err := projectReadiness(projectDir)
+	return err == nil && total > 0 && ready == total
+}
+
+// projectReadiness returns how many of the services defined by a project are up, healthy for
+// those with a healthcheck and exited successfully for one-shot ones, without checking Docker
+// interactively. Services that have no container count as not ready.
+func projectReadiness(projectDir string) (int, int, error) {
 	cm, err := docker.NewComposeManager()
 	if err != nil {
-		return false
+		return 0, 0, err
 	}
 	defer cm.Close()
 
-	statuses, err := cm.GetProjectStatus(projectDir)
+	project, err := cm.LoadProject(projectDir)
 	if err != nil {
-		return false
+		return 0, 0, err
 	}
-
-	if len(statuses) == 0 {
-		return false
+	states, err := cm.GetServiceReadiness(project)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	// Check if all containers are running
-	for _, status := range statuses {
-		if status.State != "running" {
-			return false
+	ready := 0
+	for _, service := range project.ServiceNames() {
+		if docker.IsReadyState(states[service]) {
+			ready++
 		}
 	}
+	return ready, len(project.Services), nil
+}
+
+// probeProjectsHealth prints one summary line per project and sets a non-zero exit code when one
+// is not healthy, without any prompt. With minHealthy, a project passes when at least that many
+// of its services are up.
+func probeProjectsHealth(projectNames []string, minHealthy int) {
+	for _, projectName := range projectNames {
+		projectPath := docker.Projects[projectName]
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("❌ %s: failed to resolve path: %v\n", projectName, err)
+			setExitCode(ExitFailure)
+			continue
+		}
+
+		ready, total, err := projectReadiness(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			continue
+		}
 
-	return true
+		healthy := total > 0 && ready == total
+		summary := fmt.Sprintf("%d/%d services up", ready, total)
+		if minHealthy > 0 {
+			healthy = ready >= minHealthy
+			summary += fmt.Sprintf(" (minimum %d)", minHealthy)
+		}
+
+		if healthy {
+			fmt.Printf("✅ %s: healthy, %s\n", projectName, summary)
+			continue
+		}
+		fmt.Printf("❌ %s: unhealthy, %s\n", projectName, summary)
+		setExitCode(ExitFailure)
+	}
 }
 
-func offerHealthSolutions(projectName, projectDir string, hasErrors, hasStopped bool) {
+func offerHealthSolutions(projectName, projectDir string, hasErrors, hasStopped bool, unhealthyServices []string) {
 	var solutions []string
 
+	if len(unhealthyServices) > 0 {
+		solutions = append(solutions, "Repair a specific service")
+	}
+
 	if hasErrors {
 		solutions = append(solutions, "View logs to diagnose errors")
 		solutions = append(solutions, "Restart containers with errors")
@@ -225,6 +343,7 @@ func offerHealthSolutions(projectName, projectDir string, hasErrors, hasStopped
 	cm, err := docker.NewComposeManager()
 	if err != nil {
 		fmt.Printf("Failed to create compose manager: %v\n", err)
+		setExitCodeForError(err)
 		return
 	}
 	defer func(cm *docker.ComposeManager) {
@@ -237,6 +356,9 @@ func offerHealthSolutions(projectName, projectDir string, hasErrors, hasStopped
 	}(cm)
 
 	switch solution {
+	case "Repair a specific service":
+		repairService(cm, projectName, projectDir, unhealthyServices)
+
 	case "View logs to diagnose errors":
 		fmt.Printf("📋 Viewing logs for project %s:\n", projectName)
 		err := cm.ViewLogs(projectDir, []string{}, false)
@@ -246,9 +368,10 @@ func offerHealthSolutions(projectName, projectDir string, hasErrors, hasStopped
 
 	case "Restart containers with errors", "Start stopped containers", "Full project restart":
 		fmt.Printf("🔄 Restarting project %s...\n", projectName)
-		err := cm.RestartProject(projectDir)
+		err := cm.RestartProject(projectDir, docker.RestartOptions{})
 		if err != nil {
 			fmt.Printf("❌ Failed to restart project: %v\n", err)
+			setExitCodeForError(err)
 		} else {
 			fmt.Printf("✅ Project %s restarted successfully!\n", projectName)
 			fmt.Println("⏳ Checking health in 3 seconds...")
@@ -268,6 +391,67 @@ func offerHealthSolutions(projectName, projectDir string, hasErrors, hasStopped
 	}
 }
 
+// repairService lets the user pick one unhealthy service and act on it alone,
+// leaving the healthy services of the project untouched
+func repairService(cm *docker.ComposeManager, projectName, projectDir string, unhealthyServices []string) {
+	service := unhealthyServices[0]
+	if len(unhealthyServices) > 1 {
+		servicePrompt := &survey.Select{
+			Message: "Which service do you want to repair?",
+			Options: unhealthyServices,
+		}
+		if err := survey.AskOne(servicePrompt, &service); err != nil {
+			return
+		}
+	}
+
+	viewLogs := fmt.Sprintf("View logs of %s", service)
+	restart := fmt.Sprintf("Restart just %s", service)
+	recreate := fmt.Sprintf("Recreate just %s", service)
+
+	var action string
+	actionPrompt := &survey.Select{
+		Message: fmt.Sprintf("What would you like to do with %s?", service),
+		Options: []string{viewLogs, restart, recreate, "Do nothing for now"},
+	}
+	if err := survey.AskOne(actionPrompt, &action); err != nil {
+		return
+	}
+
+	var err error
+	switch action {
+	case viewLogs:
+		fmt.Printf("📋 Viewing logs for service %s:\n", service)
+		if err := cm.ViewLogs(projectDir, []string{service}, false); err != nil {
+			fmt.Printf("❌ Failed to view logs: %v\n", err)
+			setExitCodeForError(err)
+		}
+		return
+	case restart:
+		err = cm.RestartServices(projectDir, []string{service}, docker.RestartOptions{})
+	case recreate:
+		err = cm.RecreateServices(projectDir, []string{service})
+	default:
+		fmt.Println("👍 No action taken. You can run this health check again anytime.")
+		return
+	}
+
+	if err != nil {
+		fmt.Printf("❌ Failed to repair service %s: %v\n", service, err)
+		setExitCodeForError(err)
+		return
+	}
+
+	fmt.Println("⏳ Checking health in 3 seconds...")
+	time.Sleep(3 * time.Second)
+
+	if checkProjectHealthQuiet(projectName, projectDir) {
+		fmt.Println("✅ Project is now healthy!")
+	} else {
+		fmt.Println("⚠️  Some issues may remain - run health check again if needed")
+	}
+}
+
 func fixAllProjectIssues(projects []string) {
 	fmt.Printf("🔧 Fixing issues for %d projects...\n", len(projects))
 
@@ -290,7 +474,7 @@ func fixAllProjectIssues(projects []string) {
 			continue
 		}
 
-		err = cm.RestartProject(projectDir)
+		err = cm.RestartProject(projectDir, docker.RestartOptions{})
 		err = cm.Close()
 		if err != nil {
 			fmt.Printf("❌ Failed to close compose manager for %s: %v\n", projectName, err)
@@ -305,11 +489,12 @@ func fixAllProjectIssues(projects []string) {
 func selectAndFixProjects(projects []string) {
 	var selectedProjects []string
 	prompt := &survey.MultiSelect{
-		Message: "Select projects to fix:",
-		Options: projects,
+		Message:     "Select projects to fix:",
+		Options:     projects,
+		Description: docker.ProjectNoteDescription,
 	}
 
-	err := survey.AskOne(prompt, &selectedProjects)
+	err := survey.AskOne(prompt, &selectedProjects, docker.ProjectSelectOptions()...)
 	if err != nil {
 		return
 	}
@@ -320,5 +505,7 @@ func selectAndFixProjects(projects []string) {
 }
 
 func init() {
+	healthCmd.Flags().BoolVar(&healthCheck, "check", false, "Print a one-line summary and exit non-zero when unhealthy, without prompting")
+	healthCmd.Flags().IntVar(&healthMinHealthy, "min-healthy", 0, "With --check, pass when at least this many services are up")
 	rootCmd.AddCommand(healthCmd)
 }
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var healthWatchInterval time.Duration
+
+var healthWatchCmd = &cobra.Command{
+	Use:   "watch [project]",
+	Short: "Follow the healthcheck transitions of a project's containers",
+	Long: `Poll the healthcheck status of every container of a project and print each transition, such as
+starting → healthy or healthy → unhealthy, until interrupted. The output of the failing probe is shown
+when a container becomes unhealthy, and a summary of the transitions per container is printed on exit.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if healthWatchInterval <= 0 {
+			fmt.Println("The --interval flag must be a positive duration")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("❌ Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		watchHealth(cm, projectName, project.Name)
+	},
+}
+
+// watchHealth prints the healthcheck transitions of a project's containers until interrupted
+func watchHealth(cm *docker.ComposeManager, projectName, composeProject string) {
+	samples, err := cm.SampleHealth(composeProject)
+	if err != nil {
+		fmt.Printf("❌ Failed to get health of project %s: %v\n", projectName, err)
+		setExitCodeForError(err)
+		return
+	}
+
+	fmt.Printf("🩺 Watching healthchecks of '%s' every %s\n", projectName, healthWatchInterval)
+	fmt.Println("   Press Ctrl+C to stop")
+	fmt.Println()
+
+	last := make(map[string]docker.HealthSample)
+	transitions := make(map[string]int)
+	for _, sample := range samples {
+		fmt.Printf("   %s %-30s %s\n", healthIcon(sample.Status), sample.Container, sample.Status)
+		last[sample.Container] = sample
+	}
+	if len(samples) == 0 {
+		fmt.Printf("📭 No containers found for project '%s', waiting for some to appear\n", projectName)
+	}
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupts)
+
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-interrupts:
+			fmt.Println()
+			printHealthTransitions(transitions)
+			return
+
+		case <-ticker.C:
+			samples, err := cm.SampleHealth(composeProject)
+			if err != nil {
+				fmt.Printf("⚠️  Failed to get health: %v\n", err)
+				continue
+			}
+
+			seen := make(map[string]bool, len(samples))
+			for _, sample := range samples {
+				seen[sample.Container] = true
+				previous, known := last[sample.Container]
+				last[sample.Container] = sample
+				if known && previous.Status == sample.Status {
+					continue
+				}
+
+				from := "new"
+				if known {
+					from = previous.Status
+					transitions[sample.Container]++
+				}
+				printHealthTransition(sample, from)
+			}
+
+			for name := range last {
+				if !seen[name] {
+					fmt.Printf("[%s] 🗑️  %s was removed\n", time.Now().Format("15:04:05"), name)
+					delete(last, name)
+				}
+			}
+		}
+	}
+}
+
+// printHealthTransition prints one status change, with the probe output when the container turned unhealthy
+func printHealthTransition(sample docker.HealthSample, from string) {
+	fmt.Printf("[%s] %s %s: %s → %s\n", time.Now().Format("15:04:05"), healthIcon(sample.Status), sample.Container, from, sample.Status)
+	if sample.Status != "unhealthy" {
+		return
+	}
+
+	fmt.Printf("   Failing streak: %d, last probe exit code: %d\n", sample.FailingStreak, sample.ExitCode)
+	if sample.Output == "" {
+		return
+	}
+	for _, line := range strings.Split(sample.Output, "\n") {
+		fmt.Printf("   │ %s\n", line)
+	}
+}
+
+// printHealthTransitions summarizes how often each container changed status while watching
+func printHealthTransitions(transitions map[string]int) {
+	if len(transitions) == 0 {
+		fmt.Println("✅ No healthcheck transition observed")
+		return
+	}
+
+	fmt.Println("📊 Healthcheck transitions:")
+	for _, name := range slices.Sorted(maps.Keys(transitions)) {
+		fmt.Printf("   %-30s %d\n", name, transitions[name])
+	}
+}
+
+// healthIcon returns the icon of a healthcheck status
+func healthIcon(status string) string {
+	switch status {
+	case "healthy":
+		return "🟢"
+	case "unhealthy":
+		return "🔴"
+	case "starting":
+		return "🟡"
+	case docker.HealthNone:
+		return "⚪"
+	default:
+		return "⚫"
+	}
+}
+
+func init() {
+	healthWatchCmd.Flags().DurationVar(&healthWatchInterval, "interval", 2*time.Second, "Time between two healthcheck polls")
+	healthCmd.AddCommand(healthWatchCmd)
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var incidentAround string
+var incidentWindow time.Duration
+
+// incidentTimeLayout aligns the timestamps of merged log lines
+const incidentTimeLayout = "2006-01-02 15:04:05.000"
+
+var incidentCmd = &cobra.Command{
+	Use:   "incident [project]",
+	Short: "Show the logs of all services around a point in time",
+	Long: `Read the logs every service of a project wrote in a window centered on a given time and merge them in chronological order, to reconstruct what happened across services around an incident.
+--around takes a duration back from now such as 2h, or a date such as 2024-05-01T15:04:05Z or "2024-05-01 15:04:05" in local time. A marker line shows where the incident time falls.`,
+	Example: `  dockyard incident myapp --around "2024-05-01 15:04:05"
+  dockyard incident myapp --around 45m --window 10m`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		if incidentAround == "" {
+			fmt.Println("❌ Pass the time of the incident with --around")
+			setExitCode(ExitFailure)
+			return
+		}
+		around, err := docker.ParseSince(incidentAround)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		if incidentWindow <= 0 {
+			fmt.Println("❌ --window must be a positive duration such as 5m")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		incident, err := cm.ProjectIncidentLogs(projectDir, around, incidentWindow)
+		if err != nil {
+			fmt.Printf("❌ Failed to read the logs of project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		displayIncident(projectName, around, incident)
+	},
+}
+
+// displayIncident prints the merged log lines with aligned timestamps and service prefixes
+func displayIncident(projectName string, around time.Time, incident *docker.IncidentLogs) {
+	fmt.Printf("🚨 Logs of project '%s' from %s to %s:\n\n", projectName,
+		incident.Since.Local().Format(incidentTimeLayout), incident.Until.Local().Format(incidentTimeLayout))
+
+	width := 0
+	for _, line := range incident.Lines {
+		width = max(width, len(line.Container))
+	}
+
+	marker := fmt.Sprintf("%s ──── incident time ────", around.Local().Format(incidentTimeLayout))
+	markerShown := false
+	for _, line := range incident.Lines {
+		if !markerShown && !line.Time.Before(around) {
+			fmt.Println(ui.RenderWarning(marker))
+			markerShown = true
+		}
+		fmt.Printf("%s %s %s\n", line.Time.Local().Format(incidentTimeLayout),
+			ui.RenderServicePrefix(line.Service, line.Container, width), line.Message)
+	}
+	if !markerShown {
+		fmt.Println(ui.RenderWarning(marker))
+	}
+
+	if len(incident.Lines) == 0 {
+		fmt.Println("📭 No service logged anything in this window")
+	}
+
+	if len(incident.Failed) > 0 {
+		fmt.Println()
+		services := make([]string, 0, len(incident.Failed))
+		for service := range incident.Failed {
+			services = append(services, service)
+		}
+		sort.Strings(services)
+		for _, service := range services {
+			fmt.Printf("⚠️  Failed to read the logs of %s: %s\n", service, strings.TrimSpace(incident.Failed[service]))
+		}
+		setExitCode(ExitFailure)
+	}
+}
+
+func init() {
+	incidentCmd.Flags().StringVar(&incidentAround, "around", "", "Time of the incident, a duration ago or a date")
+	incidentCmd.Flags().DurationVar(&incidentWindow, "window", docker.DefaultIncidentWindow, "Width of the window centered on the incident")
+	rootCmd.AddCommand(incidentCmd)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var killSignal string
+
+var killCmd = &cobra.Command{
+	Use:   "kill [project] [service...]",
+	Short: "Force-stop a Docker project or some of its services",
+	Long: `Send a signal to the containers of a Docker project, SIGKILL by default, for when a graceful stop hangs.
+Name services after the project to kill only those. The signal can be given as a name (KILL, SIGTERM) or a number.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+		services := args[1:]
+
+		if _, err := docker.NormalizeSignal(killSignal); err != nil {
+			fmt.Printf("❌ Invalid --signal: %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		if len(services) > 0 {
+			err = cm.KillServices(projectDir, services, killSignal)
+		} else {
+			err = cm.KillProject(projectDir, killSignal)
+		}
+		reportOperation(projectName, "kill", err)
+		if err != nil {
+			fmt.Printf("Failed to kill project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	killCmd.Flags().StringVarP(&killSignal, "signal", "s", "", "Signal to send to the containers (default SIGKILL)")
+	rootCmd.AddCommand(killCmd)
+}
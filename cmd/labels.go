@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var showAllLabels bool
+
+var labelsCmd = &cobra.Command{
+	Use:   "labels [project]",
+	Short: "Show the compose labels of a project's containers",
+	Long: `List the com.docker.compose.* labels of each container of a project, grouped by container.
+Containers started from the project directory under another project name are listed too, since
+dockyard only finds containers whose com.docker.compose.project label matches the project.
+Use --all to include labels that were not set by compose.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		composeProject, containers, err := cm.GetProjectLabels(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get labels for project %s: %v\n", projectName, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Printf("🏷️  Containers are matched with %s=%s\n\n", docker.LabelComposeProject, composeProject)
+
+		if len(containers) == 0 {
+			fmt.Printf("📭 No containers found for project '%s'\n", projectName)
+			return
+		}
+
+		for _, container := range containers {
+			displayContainerLabels(container)
+		}
+	},
+}
+
+// displayContainerLabels prints the labels of a container sorted by key
+func displayContainerLabels(container docker.ContainerLabels) {
+	if container.Matched {
+		fmt.Printf("📦 %s (%s)\n", container.Name, container.Service)
+	} else {
+		fmt.Printf("❓ %s (%s) - not matched, started under project %q\n",
+			container.Name, container.Service, container.Labels[docker.LabelComposeProject])
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(container.Labels)) {
+		if !showAllLabels && !strings.HasPrefix(key, "com.docker.compose.") {
+			continue
+		}
+		fmt.Printf("   %s=%s\n", key, container.Labels[key])
+	}
+	fmt.Println()
+}
+
+func init() {
+	labelsCmd.Flags().BoolVar(&showAllLabels, "all", false, "Show all labels, not only those set by compose")
+	rootCmd.AddCommand(labelsCmd)
+}
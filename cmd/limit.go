@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	limitMemory string
+	limitCPUs   float64
+)
+
+var limitCmd = &cobra.Command{
+	Use:   "limit",
+	Short: "Change container resource limits",
+	Long:  `Change the CPU and memory limits of a project's containers without editing its compose file.`,
+}
+
+var limitSetCmd = &cobra.Command{
+	Use:   "set [project] [service]",
+	Short: "Update the memory and CPU limits of a running service",
+	Long: `Apply memory and CPU limits to the running containers of a service without recreating them.
+The current and new limits are shown for each container. When the service is not running the
+limits are kept in projects.json and applied the next time the project is started.
+
+Memory and CPU limits update live. Other constraints such as ulimits, devices or the cpuset need
+the container to be recreated, and live changes are lost when compose recreates the container,
+so add the limits to the compose file to keep them.`,
+	Example: `  dockyard limit set myapp web --memory 512m --cpus 1.5`,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+		service := args[1]
+
+		limits := docker.ServiceLimits{Memory: limitMemory, CPUs: limitCPUs}
+		if err := limits.Validate(); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		updates, err := cm.UpdateServiceLimits(project, service, limits)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(updates) == 0 {
+			if err := docker.SavePendingLimits(projectName, service, limits); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+			fmt.Printf("⏸️  Service %s is not running, the limits will be applied when project %s starts\n", service, projectName)
+			return
+		}
+
+		if !displayLimitUpdates(updates, limits) {
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Println("💡 Live limits are lost when the container is recreated, add them to the compose file to keep them")
+	},
+}
+
+func init() {
+	limitSetCmd.Flags().StringVar(&limitMemory, "memory", "", "Memory limit, such as 512m or 2g")
+	limitSetCmd.Flags().Float64Var(&limitCPUs, "cpus", 0, "Number of CPUs, such as 1.5")
+	limitCmd.AddCommand(limitSetCmd)
+	rootCmd.AddCommand(limitCmd)
+}
+
+// displayLimitUpdates prints the old and new limits of each container, returning false if any update failed
+func displayLimitUpdates(updates []docker.LimitUpdate, limits docker.ServiceLimits) bool {
+	memory, _ := limits.MemoryBytes()
+
+	succeeded := true
+	for _, update := range updates {
+		if update.Err != nil {
+			fmt.Printf("⚠️  %s: %v\n", update.Container, update.Err)
+			succeeded = false
+			continue
+		}
+
+		fmt.Printf("✅ %s\n", update.Container)
+		if memory > 0 {
+			fmt.Printf("   memory: %s → %s\n", formatMemoryLimit(update.OldMemory), formatMemoryLimit(memory))
+		}
+		if limits.CPUs > 0 {
+			fmt.Printf("   cpus:   %s → %s\n", formatCPULimit(update.OldCPUs), formatCPULimit(limits.CPUs))
+		}
+		for _, warning := range update.Warnings {
+			fmt.Printf("   ⚠️  %s\n", warning)
+		}
+	}
+	return succeeded
+}
+
+// applyPendingLimits applies limits saved while a service was stopped to the freshly started project
+func applyPendingLimits(cm *docker.ComposeManager, projectName, projectDir string) {
+	pending := docker.ProjectsSettings[projectName].PendingLimits
+	if len(pending) == 0 {
+		return
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to apply pending limits of project %s: %v\n", projectName, err)
+		return
+	}
+
+	for service, limits := range pending {
+		updates, err := cm.UpdateServiceLimits(project, service, limits)
+		if err != nil {
+			fmt.Printf("⚠️  Failed to apply pending limits to %s: %v\n", service, err)
+			continue
+		}
+		if len(updates) == 0 {
+			continue
+		}
+
+		fmt.Printf("📏 Applying pending limits to %s\n", service)
+		displayLimitUpdates(updates, limits)
+		if err := docker.ClearPendingLimits(projectName, service); err != nil {
+			fmt.Printf("⚠️  %v\n", err)
+		}
+	}
+}
+
+func formatMemoryLimit(bytes int64) string {
+	if bytes <= 0 {
+		return "unlimited"
+	}
+	return docker.FormatBytes(bytes)
+}
+
+func formatCPULimit(cpus float64) string {
+	if cpus <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%.2f", cpus)
+}
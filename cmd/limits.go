@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var limitsCmd = &cobra.Command{
+	Use:   "limits [project]",
+	Short: "Show container resource limits vs usage",
+	Long:  `Display the configured CPU and memory limits of each container in a project alongside its current usage, flagging containers near their limit.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		usages, err := cm.GetProjectResourceUsage(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get resource usage for project %s: %v\n", projectName, err)
+			return
+		}
+
+		if len(usages) == 0 {
+			fmt.Printf("📭 No containers found for project '%s'\n", projectName)
+			return
+		}
+
+		displayResourceUsage(projectName, usages)
+	},
+}
+
+// displayResourceUsage prints limits and usage for each container as a table
+func displayResourceUsage(projectName string, usages []docker.ResourceUsage) {
+	fmt.Printf("📏 Resource limits for project '%s':\n", projectName)
+	fmt.Printf("   %-25s %-22s %-8s %-18s %s\n", "SERVICE", "MEM USAGE / LIMIT", "MEM %", "CPU % / LIMIT", "CPU LIMIT %")
+	fmt.Println(strings.Repeat("-", 90))
+
+	nearLimit := 0
+	unlimited := 0
+	for _, usage := range usages {
+		marker := "  "
+		if usage.IsNearLimit() {
+			marker = "⚠️"
+			nearLimit++
+		}
+		if usage.MemoryLimit == 0 || usage.CPULimit == 0 {
+			unlimited++
+		}
+
+		fmt.Printf("%s %-25s %-22s %-8s %-18s %s\n",
+			marker,
+			usage.Service,
+			formatMemoryUsage(usage),
+			formatLimitPercent(usage.HasStats, usage.MemoryLimit > 0, usage.MemoryPercent()),
+			formatCPUUsage(usage),
+			formatLimitPercent(usage.HasStats, usage.CPULimit > 0, usage.CPULimitPercent()))
+	}
+
+	fmt.Println()
+	if nearLimit > 0 {
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("%d container(s) above %.0f%% of their limit", nearLimit, docker.NearLimitThreshold)))
+	}
+	if unlimited > 0 {
+		fmt.Println(ui.RenderInfo(fmt.Sprintf("%d container(s) have no CPU or memory limit and can starve the host", unlimited)))
+	}
+}
+
+func formatMemoryUsage(usage docker.ResourceUsage) string {
+	limit := "no limit"
+	if usage.MemoryLimit > 0 {
+		limit = docker.FormatBytes(usage.MemoryLimit)
+	}
+
+	if !usage.HasStats {
+		return fmt.Sprintf("- / %s", limit)
+	}
+	return fmt.Sprintf("%s / %s", docker.FormatBytes(usage.MemoryUsage), limit)
+}
+
+func formatCPUUsage(usage docker.ResourceUsage) string {
+	limit := "no limit"
+	if usage.CPULimit > 0 {
+		limit = fmt.Sprintf("%.2f cpus", usage.CPULimit)
+	}
+
+	if !usage.HasStats {
+		return fmt.Sprintf("- / %s", limit)
+	}
+	return fmt.Sprintf("%.1f%% / %s", usage.CPUPercent, limit)
+}
+
+func formatLimitPercent(hasStats, hasLimit bool, percent float64) string {
+	if !hasStats || !hasLimit {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", percent)
+}
+
+func init() {
+	rootCmd.AddCommand(limitsCmd)
+}
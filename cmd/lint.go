@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var lintStrict bool
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [project]",
+	Short: "Check a compose file against best practices",
+	Long: `Check the services of a project for common compose file problems:
+  high    privileged containers, secrets written in the compose file
+  medium  images using the latest tag or no tag, host network mode
+  low     missing healthchecks, missing restart policies
+Secrets are environment keys matching the secret key patterns (see DOCKYARD_SECRET_KEYS) whose value does not reference a variable.
+Findings are warnings, use --strict to exit with a failure when there is any.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		findings, err := docker.LintProject(projectDir, project)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(findings) == 0 {
+			fmt.Println(ui.RenderSuccess(fmt.Sprintf("No lint issues in %s", projectName)))
+			return
+		}
+
+		displayLintFindings(projectName, findings)
+		if lintStrict {
+			setExitCode(ExitFailure)
+		}
+	},
+}
+
+// displayLintFindings prints each finding with its severity and a count per severity
+func displayLintFindings(projectName string, findings []docker.LintFinding) {
+	fmt.Printf("🔍 Lint findings in project '%s':\n", projectName)
+
+	counts := make(map[string]int)
+	for _, finding := range findings {
+		counts[finding.Severity]++
+		fmt.Printf("%s [%s] %s: %s (%s)\n", lintEmoji(finding.Severity), finding.Severity, finding.Service, finding.Message, finding.Rule)
+	}
+	fmt.Println()
+
+	fmt.Println(ui.RenderWarning(fmt.Sprintf("%d finding(s): %d high, %d medium, %d low",
+		len(findings), counts[docker.LintHigh], counts[docker.LintMedium], counts[docker.LintLow])))
+}
+
+func lintEmoji(severity string) string {
+	switch severity {
+	case docker.LintHigh:
+		return "❌"
+	case docker.LintMedium:
+		return "⚠️ "
+	default:
+		return "💡"
+	}
+}
+
+func init() {
+	lintCmd.Flags().BoolVar(&lintStrict, "strict", false, "Exit with a failure when there is any finding")
+	rootCmd.AddCommand(lintCmd)
+}
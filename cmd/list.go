@@ -23,11 +23,16 @@ var listCmd = &cobra.Command{
 				continue
 			}
 			composeFilePath, err := utils.GetComposeFilePath(projectDir)
+			if err != nil && utils.IsRemoteSource(projectPath) {
+				// Remote sources are only fetched when the project is loaded
+				fmt.Printf("- %s (%s, not fetched yet)%s\n", projectName, projectPath, projectNoteSuffix(projectName))
+				continue
+			}
 			if err != nil {
 				fmt.Printf("Failed to find docker-compose file in %s: %v\n", projectDir, err)
 				continue
 			}
-			fmt.Printf("- %s (%s)\n", projectName, composeFilePath)
+			fmt.Printf("- %s (%s)%s\n", projectName, composeFilePath, projectNoteSuffix(projectName))
 		}
 	},
 }
@@ -4,54 +4,304 @@ import (
 	"dockyard/pkg/docker"
 	"dockyard/pkg/utils"
 	"fmt"
+	"path/filepath"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 )
 
 var (
-	follow bool
+	follow        bool
+	replicaIndex  int
+	redactLogs    bool
+	colorLogs     bool
+	jsonLogs      bool
+	localTimeLogs bool
+
+	backgroundLogs bool
+	stopLogs       bool
+	captureLogs    bool
+	logsOutDir     string
+	logsMaxSize    int
+
+	allLogs bool
+
+	logsBundle      string
+	logsSince       string
+	logsNoRedact    bool
+	logsBundleForce bool
 )
 
 var logsCmd = &cobra.Command{
 	Use:   "logs [project] [service...]",
 	Short: "View logs for services in a project",
-	Long:  `Display logs for specific services within a Docker project. If no services specified, shows logs for all services.`,
-	Args:  cobra.MinimumNArgs(1),
+	Long: `Display logs for specific services within a Docker project. If no services are specified on a terminal, pick the
+services to show from a list, and whether to follow them unless --follow is given. Selecting none, --all or
+running outside a terminal shows the logs of all services.
+Use --index to target a single replica of a scaled service instead of aggregating all of them.
+Use --redact to hide the values of secret environment keys (see DOCKYARD_SECRET_KEYS) before sharing the output.
+Use --color to give each service a stable color and align the service names in a column.
+Use --json to wrap lines logged as JSON objects with their service, container and timestamp,
+for structured log viewers. Other lines are printed unchanged.
+Use --local-time to prefix lines with their timestamp converted to the local time zone, also while following.
+Use --background to keep writing the logs of each service to <out>/<service>.log after the
+command returns, rotating files above --max-size MB, and --stop to end the capture.
+Use --bundle out.zip to package the logs of the given services, or of every service, the docker compose config
+output and a manifest into a zip archive to attach to a bug report, limited to recent logs with --since.
+Secret values are masked in the bundle unless --no-redact is given, and an existing archive is only
+overwritten with --force.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
 		var targetServices []string
 
 		if len(args) > 1 {
 			targetServices = args[1:]
 		}
 
-		projectPath, ok := docker.Projects[projectName]
+		if jsonLogs && colorLogs {
+			fmt.Println("The --json and --color flags cannot be combined")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if logsBundle == "" && (logsSince != "" || logsNoRedact || logsBundleForce) {
+			fmt.Println("The --since, --no-redact and --force flags require --bundle")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if replicaIndex < 0 {
+			fmt.Println("The --index flag cannot be negative")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if replicaIndex > 0 && len(targetServices) != 1 {
+			fmt.Println("The --index flag requires exactly one service")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		switch {
+		case logsBundle != "":
+			bundleLogs(projectName, projectDir, targetServices)
+			return
+		case stopLogs:
+			stopBackgroundLogs(projectName)
+			return
+		case backgroundLogs:
+			startBackgroundLogs(projectName, projectDir)
+			return
+		case captureLogs:
+			if err := docker.CaptureLogs(projectDir, logsOutDir, int64(logsMaxSize)*1024*1024); err != nil {
+				fmt.Printf("Failed to capture logs: %v\n", err)
+				setExitCode(ExitFailure)
+			}
 			return
 		}
 
 		cm, err := docker.NewComposeManager()
 		if err != nil {
 			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
 			return
 		}
 		defer cm.Close()
 
-		if err := cm.ViewLogs(projectDir, targetServices, follow); err != nil {
+		if len(targetServices) == 0 && !allLogs && docker.IsInteractiveTerminal() {
+			targetServices, ok = pickLogServices(cm, projectDir, !cmd.Flags().Changed("follow"))
+			if !ok {
+				return
+			}
+		}
+
+		if replicaIndex > 0 {
+			if err := cm.ViewReplicaLogs(projectDir, targetServices[0], replicaIndex, follow); err != nil {
+				fmt.Printf("Failed to view logs: %v\n", err)
+				setExitCodeForError(err)
+			}
+			return
+		}
+
+		if redactLogs || colorLogs || jsonLogs || localTimeLogs {
+			err = cm.ViewProcessedLogs(projectDir, targetServices, docker.LogOptions{
+				Follow:    follow,
+				Redact:    redactLogs,
+				Color:     colorLogs,
+				JSON:      jsonLogs,
+				LocalTime: localTimeLogs,
+			})
+		} else {
+			err = cm.ViewLogs(projectDir, targetServices, follow)
+		}
+		if err != nil {
 			fmt.Printf("Failed to view logs: %v\n", err)
+			setExitCodeForError(err)
 			return
 		}
 	},
 }
 
+// pickLogServices asks which services of the project to show logs for and, when askFollow is set,
+// whether to follow them. No selection means all services.
+func pickLogServices(cm *docker.ComposeManager, projectDir string, askFollow bool) ([]string, bool) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		fmt.Printf("Failed to load project: %v\n", err)
+		setExitCodeForError(err)
+		return nil, false
+	}
+
+	var selected []string
+	prompt := &survey.MultiSelect{
+		Message: "Select services to show logs for (leave empty for all):",
+		Options: project.ServiceNames(),
+	}
+	if err := survey.AskOne(prompt, &selected); err != nil {
+		return nil, false
+	}
+
+	if askFollow {
+		followPrompt := &survey.Confirm{
+			Message: "Follow the logs?",
+			Default: true,
+		}
+		if err := survey.AskOne(followPrompt, &follow); err != nil {
+			return nil, false
+		}
+	}
+
+	return selected, true
+}
+
+// bundleLogs writes the logs bundle of a project and summarizes its content
+func bundleLogs(projectName, projectDir string, services []string) {
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Printf("Failed to create compose manager: %v\n", err)
+		setExitCodeForError(err)
+		return
+	}
+	defer cm.Close()
+
+	manifest, err := cm.BundleLogs(projectName, projectDir, logsBundle, docker.LogBundleOptions{
+		Since:    logsSince,
+		Services: services,
+		Redact:   !logsNoRedact,
+		Force:    logsBundleForce,
+	})
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+
+	fmt.Printf("📦 Bundled logs of %s into %s:\n", projectName, logsBundle)
+	for _, service := range manifest.Services {
+		if service.Error != "" {
+			fmt.Printf("   ⚠️  %s: %s\n", service.Service, service.Error)
+			continue
+		}
+		fmt.Printf("   • %s: %d line(s)\n", service.Service, service.Lines)
+	}
+	if !manifest.Redacted {
+		fmt.Println("⚠️  Secret values were not masked, check the bundle before sharing it")
+	}
+}
+
+// startBackgroundLogs starts a detached dockyard process capturing the logs of a project to files
+func startBackgroundLogs(projectName, projectDir string) {
+	if process, found, err := utils.LoadBackgroundProcess(projectName); err == nil && found && process.Running() {
+		fmt.Printf("⚠️  Logs of %s are already captured to %s (PID %d)\n", projectName, process.OutDir, process.PID)
+		fmt.Printf("💡 Tip: Run 'dockyard logs %s --stop' to end it\n", projectName)
+		setExitCode(ExitFailure)
+		return
+	}
+
+	outDir := logsOutDir
+	if outDir == "" {
+		outDir = filepath.Join("logs", projectName)
+	}
+	outDir, err := filepath.Abs(outDir)
+	if err != nil {
+		fmt.Printf("❌ Invalid output directory: %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+
+	args := []string{"logs", projectName, "--capture", "--out", outDir, "--max-size", fmt.Sprint(logsMaxSize), "--project-dir", projectDir}
+	if composeFileOverride != "" {
+		args = append(args, "--file", composeFileOverride)
+	}
+
+	process, err := utils.StartBackground(projectName, outDir, args)
+	if err != nil {
+		fmt.Printf("❌ Failed to capture logs of %s: %v\n", projectName, err)
+		setExitCode(ExitFailure)
+		return
+	}
+
+	fmt.Printf("📝 Capturing logs of %s to %s (PID %d)\n", projectName, outDir, process.PID)
+	fmt.Printf("💡 Tip: Run 'dockyard logs %s --stop' to end the capture\n", projectName)
+}
+
+// stopBackgroundLogs ends the background log capture of a project
+func stopBackgroundLogs(projectName string) {
+	process, found, err := utils.LoadBackgroundProcess(projectName)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+	if !found {
+		fmt.Printf("📭 No background log capture for %s\n", projectName)
+		return
+	}
+
+	running := process.Running()
+	if err := utils.StopBackgroundProcess(process); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+
+	if running {
+		fmt.Printf("✅ Stopped capturing logs of %s, files are in %s\n", projectName, process.OutDir)
+	} else {
+		fmt.Printf("⚠️  The log capture of %s had already exited, files are in %s\n", projectName, process.OutDir)
+	}
+}
+
 func init() {
 	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "Follow log output")
+	logsCmd.Flags().IntVar(&replicaIndex, "index", 0, "Show logs for the Nth replica of the service only (default: all replicas)")
+	logsCmd.Flags().BoolVar(&redactLogs, "redact", false, "Hide the values of secret environment keys in the output")
+	logsCmd.Flags().BoolVar(&colorLogs, "color", false, "Color-code and align service names in the output")
+	logsCmd.Flags().BoolVar(&jsonLogs, "json", false, "Wrap JSON log lines with service and timestamp metadata")
+	logsCmd.Flags().BoolVar(&localTimeLogs, "local-time", false, "Show timestamps converted to the local time zone")
+	logsCmd.Flags().BoolVar(&backgroundLogs, "background", false, "Keep capturing logs to per-service files in the background")
+	logsCmd.Flags().BoolVar(&stopLogs, "stop", false, "Stop the background log capture of the project")
+	logsCmd.Flags().StringVar(&logsOutDir, "out", "", "Directory of the background log files (default logs/<project>)")
+	logsCmd.Flags().IntVar(&logsMaxSize, "max-size", 10, "Size in MB above which a background log file is rotated")
+	logsCmd.Flags().StringVar(&logsBundle, "bundle", "", "Write the logs of all services and the compose config to a zip archive")
+	logsCmd.Flags().StringVar(&logsSince, "since", "", "With --bundle, only include logs since a duration ago (e.g. 2h) or a timestamp")
+	logsCmd.Flags().BoolVar(&logsNoRedact, "no-redact", false, "With --bundle, keep secret values instead of masking them")
+	logsCmd.Flags().BoolVar(&logsBundleForce, "force", false, "With --bundle, overwrite an existing archive")
+	logsCmd.Flags().BoolVar(&allLogs, "all", false, "Show the logs of all services without asking")
+	logsCmd.Flags().BoolVar(&captureLogs, "capture", false, "Capture logs to files in the foreground, used by --background")
+	logsCmd.Flags().MarkHidden("capture")
 	rootCmd.AddCommand(logsCmd)
 }
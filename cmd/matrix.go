@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var matrixPattern string
+
+var matrixCmd = &cobra.Command{
+	Use:   "matrix [project] [env-file...]",
+	Short: "Check the compose file against several env files",
+	Long: `Run docker compose config with each env file of a project, such as .env.dev and .env.prod, and report whether it produces a valid configuration with every variable resolved.
+Env files are discovered in the project directory with --pattern (default ".env.*") unless they are listed explicitly. Relative paths are resolved against the project directory.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		envFiles := args[1:]
+		for i, envFile := range envFiles {
+			if !filepath.IsAbs(envFile) {
+				envFiles[i] = filepath.Join(projectDir, envFile)
+			}
+		}
+		if len(envFiles) == 0 {
+			envFiles, err = docker.FindEnvFiles(projectDir, matrixPattern)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+		}
+
+		if len(envFiles) == 0 {
+			fmt.Printf("📭 No env files matching %s in %s\n", matrixPattern, projectDir)
+			fmt.Println("💡 Tip: List env files explicitly, e.g. 'dockyard matrix myproject .env.staging'")
+			return
+		}
+
+		results, err := docker.TestEnvironments(projectDir, envFiles)
+		if err != nil {
+			fmt.Printf("❌ Failed to test environments: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("🧪 Environment matrix for %s:\n\n", projectName)
+		failed := 0
+		for _, result := range results {
+			name := result.EnvFile
+			if rel, err := filepath.Rel(projectDir, result.EnvFile); err == nil && !strings.HasPrefix(rel, "..") {
+				name = rel
+			}
+
+			if result.Valid() {
+				fmt.Printf("✅ %s\n", name)
+				continue
+			}
+
+			failed++
+			fmt.Printf("❌ %s\n", name)
+			if len(result.Unresolved) > 0 {
+				fmt.Printf("   unresolved: %s\n", strings.Join(result.Unresolved, ", "))
+			}
+			if result.Error != "" {
+				for _, line := range strings.Split(result.Error, "\n") {
+					fmt.Printf("   %s\n", line)
+				}
+			}
+		}
+		fmt.Println()
+
+		if failed > 0 {
+			fmt.Println(ui.RenderWarning(fmt.Sprintf("%d/%d environment(s) failed", failed, len(results))))
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("All %d environment(s) produce a valid configuration", len(results))))
+	},
+}
+
+func init() {
+	matrixCmd.Flags().StringVar(&matrixPattern, "pattern", docker.DefaultEnvFilePattern, "Glob matching the env files to test in the project directory")
+	rootCmd.AddCommand(matrixCmd)
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var metricsCmd = &cobra.Command{
+	Use:   "metrics [project]",
+	Short: "Show structural metrics of a compose file",
+	Long:  `Report the number of services, networks, volumes and environment variables of a project, how many services declare healthchecks and resource limits, and which registries their images come from, for a quick read on a stack's complexity and conventions.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		metrics := docker.ComputeMetrics(project)
+		fmt.Printf("📊 Metrics for project '%s':\n", projectName)
+		fmt.Println(ui.RenderBox(formatMetrics(metrics)))
+	},
+}
+
+// formatMetrics renders the metrics as aligned label and value lines
+func formatMetrics(metrics docker.ProjectMetrics) string {
+	lines := []string{
+		fmt.Sprintf("%-22s %d", "Services", metrics.Services),
+		fmt.Sprintf("%-22s %d", "Networks", metrics.Networks),
+		fmt.Sprintf("%-22s %d", "Volumes", metrics.Volumes),
+		fmt.Sprintf("%-22s %d", "Environment variables", metrics.EnvVars),
+		fmt.Sprintf("%-22s %d/%d", "With healthcheck", metrics.WithHealthcheck, metrics.Services),
+		fmt.Sprintf("%-22s %d/%d", "With resource limits", metrics.WithLimits, metrics.Services),
+		fmt.Sprintf("%-22s %d", "Built locally", metrics.BuiltServices),
+		fmt.Sprintf("%-22s %d", "External images", metrics.ExternalImages()),
+	}
+
+	registries := make([]string, 0, len(metrics.ImagesByRegistry))
+	for registry := range metrics.ImagesByRegistry {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+	for _, registry := range registries {
+		lines = append(lines, fmt.Sprintf("  %-20s %d", registry, metrics.ImagesByRegistry[registry]))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func init() {
+	rootCmd.AddCommand(metricsCmd)
+}
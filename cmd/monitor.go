@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	monitorInterval  time.Duration
+	restartOnFailure bool
+	restartCooldown  time.Duration
+)
+
+var monitorCmd = &cobra.Command{
+	Use:   "monitor [project...]",
+	Short: "Periodically check project health and react to failures",
+	Long: `Run the health check in a loop and notify when a project becomes unhealthy or recovers.
+Without arguments, every project that is healthy when monitoring starts is watched.
+Use --restart-on-failure to restart unhealthy projects, at most once per --cooldown.
+Sending SIGHUP reloads projects.json.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if monitorInterval <= 0 {
+			fmt.Println("The --interval flag must be a positive duration")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		var projectNames []string
+		for _, arg := range args {
+			projectName, ok := resolveProjectName(arg)
+			if !ok {
+				return
+			}
+			projectNames = append(projectNames, projectName)
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		monitorProjects(projectNames)
+	},
+}
+
+// projectMonitor tracks the health of a watched project between checks
+type projectMonitor struct {
+	healthy     bool
+	lastRestart time.Time
+}
+
+func monitorProjects(projectNames []string) {
+	watchAll := len(projectNames) == 0
+	monitors := make(map[string]*projectMonitor)
+
+	// Watch the given projects, or every project that is currently up
+	if watchAll {
+		projectNames = docker.GetSortedProjectNames()
+	}
+	for _, projectName := range projectNames {
+		healthy := checkMonitoredProject(projectName)
+		if healthy || !watchAll {
+			monitors[projectName] = &projectMonitor{healthy: healthy}
+		}
+	}
+
+	if len(monitors) == 0 {
+		fmt.Println("📭 No healthy project to monitor. Start a project or pass project names explicitly.")
+		return
+	}
+
+	fmt.Printf("👁️  Monitoring %d project(s) every %s", len(monitors), monitorInterval)
+	if restartOnFailure {
+		fmt.Printf(" (restart on failure, cooldown %s)", restartCooldown)
+	}
+	fmt.Println()
+	fmt.Println("   Press Ctrl+C to stop")
+
+	reloads, stopReloads := docker.NotifyProjectsReload()
+	defer stopReloads()
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupts)
+
+	ticker := time.NewTicker(monitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-interrupts:
+			fmt.Println("\n✅ Stopped monitoring")
+			return
+
+		case <-reloads:
+			if err := docker.ReloadProjectsFromFile("projects.json"); err != nil {
+				fmt.Printf("❌ Failed to reload projects.json: %v\n", err)
+				continue
+			}
+			for projectName := range monitors {
+				if _, ok := docker.Projects[projectName]; !ok {
+					fmt.Printf("🗑️  %s was removed from projects.json, no longer monitoring it\n", projectName)
+					delete(monitors, projectName)
+				}
+			}
+			fmt.Println("🔄 Reloaded projects.json")
+
+		case <-ticker.C:
+			for _, projectName := range docker.GetSortedProjectNames() {
+				if monitor, ok := monitors[projectName]; ok {
+					checkAndReact(projectName, monitor)
+				}
+			}
+		}
+	}
+}
+
+// checkAndReact checks a project and handles transitions between healthy and unhealthy
+func checkAndReact(projectName string, monitor *projectMonitor) {
+	healthy := checkMonitoredProject(projectName)
+	wasHealthy := monitor.healthy
+	monitor.healthy = healthy
+
+	switch {
+	case healthy && !wasHealthy:
+		notifyMonitorEvent(fmt.Sprintf("✅ %s is healthy again", projectName))
+		reportOperation(projectName, "health", nil)
+		return
+	case healthy:
+		return
+	case wasHealthy:
+		notifyMonitorEvent(fmt.Sprintf("❌ %s became unhealthy", projectName))
+		reportOperation(projectName, "health", fmt.Errorf("project became unhealthy"))
+	}
+
+	if !restartOnFailure {
+		return
+	}
+
+	if since := time.Since(monitor.lastRestart); since < restartCooldown {
+		if wasHealthy {
+			fmt.Printf("   ⏳ Not restarting %s, last restart was %s ago (cooldown %s)\n",
+				projectName, docker.FormatDuration(since), restartCooldown)
+		}
+		return
+	}
+
+	monitor.lastRestart = time.Now()
+	restartMonitoredProject(projectName)
+}
+
+func checkMonitoredProject(projectName string) bool {
+	projectDir, err := utils.ResolveHomeDir(docker.Projects[projectName])
+	if err != nil {
+		return false
+	}
+	return checkProjectHealthQuiet(projectName, projectDir)
+}
+
+func restartMonitoredProject(projectName string) {
+	projectDir, err := utils.ResolveHomeDir(docker.Projects[projectName])
+	if err != nil {
+		fmt.Printf("❌ Failed to resolve home directory for %s: %v\n", projectName, err)
+		return
+	}
+
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Printf("❌ Failed to restart %s: %v\n", projectName, err)
+		return
+	}
+	defer cm.Close()
+
+	fmt.Printf("🔄 Restarting %s...\n", projectName)
+	err = cm.RestartProject(projectDir, docker.RestartOptions{})
+	reportOperation(projectName, "restart", err)
+	if err != nil {
+		fmt.Printf("❌ Failed to restart %s: %v\n", projectName, err)
+	}
+}
+
+// notifyMonitorEvent prints a timestamped event and rings the terminal bell
+func notifyMonitorEvent(message string) {
+	fmt.Printf("\a[%s] %s\n", time.Now().Format("15:04:05"), message)
+}
+
+func init() {
+	monitorCmd.Flags().DurationVar(&monitorInterval, "interval", 30*time.Second, "Time between two health checks")
+	monitorCmd.Flags().BoolVar(&restartOnFailure, "restart-on-failure", false, "Restart projects that become unhealthy")
+	monitorCmd.Flags().DurationVar(&restartCooldown, "cooldown", 5*time.Minute, "Minimum time between two restarts of the same project")
+	rootCmd.AddCommand(monitorCmd)
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var mountsCmd = &cobra.Command{
+	Use:   "mounts [project]",
+	Short: "Show what each container actually has mounted",
+	Long: `List the bind, volume and tmpfs mounts of each container in a project as reported by the daemon, grouped by service.
+Unlike the compose file this reflects what is really mounted. Bind mounts whose host source no longer exists are highlighted.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		containers, err := cm.GetProjectMounts(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get mounts for project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(containers) == 0 {
+			fmt.Printf("📭 No containers found for project '%s'\n", projectName)
+			fmt.Printf("💡 Tip: Run 'dockyard start %s' to create and start containers\n", projectName)
+			return
+		}
+
+		fmt.Printf("💾 Mounts for project '%s':\n", projectName)
+		missing := 0
+		service := ""
+		for _, container := range containers {
+			if container.Service != service {
+				service = container.Service
+				fmt.Printf("\n🔧 %s\n", service)
+			}
+			fmt.Printf("  %s\n", container.Name)
+
+			if len(container.Mounts) == 0 {
+				fmt.Println("    (no mounts)")
+				continue
+			}
+			for _, m := range container.Mounts {
+				access := "rw"
+				if m.ReadOnly {
+					access = "ro"
+				}
+				source := m.Source
+				if source == "" {
+					source = "-"
+				}
+				line := fmt.Sprintf("%-7s %-2s %s → %s", m.Type, access, source, m.Destination)
+				if m.Missing {
+					missing++
+					line = ui.RenderWarning(line + " (host source missing)")
+				}
+				fmt.Printf("    %s\n", line)
+			}
+		}
+		fmt.Println()
+
+		if missing > 0 {
+			fmt.Printf("⚠️  %d bind mount source(s) no longer exist on the host\n", missing)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mountsCmd)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var netTestCmd = &cobra.Command{
+	Use:   "net-test [project]",
+	Short: "Test network connectivity between a project's services",
+	Long: `For each pair of services sharing a network, resolve the target service's name and try to
+reach it from inside the source container with nc, ping or bash, whichever the image provides.
+Services must be running. Pairs without a common network are skipped.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		fmt.Printf("🔌 Testing service connectivity for project '%s'...\n\n", projectName)
+		links, err := cm.TestServiceConnectivity(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to test project %s: %v\n", projectName, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(links) == 0 {
+			fmt.Println("📭 No services share a network, nothing to test.")
+			return
+		}
+
+		fmt.Printf("   %-35s %-20s %s\n", "LINK", "NETWORK", "RESULT")
+		fmt.Println(strings.Repeat("-", 75))
+
+		failed := 0
+		for _, link := range links {
+			emoji := "🟢"
+			switch link.Result {
+			case docker.LinkResolved, docker.LinkUntested:
+				emoji = "⚪"
+			case docker.LinkDNSFailed, docker.LinkUnreachable:
+				emoji = "🔴"
+				failed++
+			}
+
+			target := link.To
+			if link.Port != "" {
+				target += ":" + link.Port
+			}
+			fmt.Printf("%s %-35s %-20s %s", emoji, link.From+" → "+target, link.Network, link.Result)
+			if link.Detail != "" {
+				fmt.Printf(" (%s)", link.Detail)
+			}
+			fmt.Println()
+		}
+		fmt.Println()
+
+		if failed == 0 {
+			fmt.Println(ui.RenderSuccess("No broken link between services"))
+			return
+		}
+
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("%d link(s) failed", failed)))
+		fmt.Println("💡 Check that the target service is running, listens on 0.0.0.0 and is attached to the same network")
+		setExitCode(ExitFailure)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(netTestCmd)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var clearNote bool
+
+var noteCmd = &cobra.Command{
+	Use:   "note [project] [text...]",
+	Short: "Show or set the note of a project",
+	Long: `Annotate a project with a short note describing its purpose, e.g. "client demo env, do not stop". The note is stored in projects.json and shown by list, status and the project selection prompts.
+Without text the current note is shown. Use --clear to remove it.`,
+	Example: `  dockyard note demo "client demo env, do not stop"
+  dockyard note demo --clear`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		text := strings.TrimSpace(strings.Join(args[1:], " "))
+
+		switch {
+		case clearNote && text != "":
+			fmt.Println("❌ Pass either a note or --clear, not both")
+			setExitCode(ExitFailure)
+		case clearNote:
+			if err := docker.SetNote(projectName, ""); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+			fmt.Printf("✅ Removed the note of project '%s'\n", projectName)
+		case text == "":
+			note := docker.ProjectsSettings[projectName].Note
+			if note == "" {
+				fmt.Printf("📭 Project '%s' has no note\n", projectName)
+				fmt.Printf("💡 Tip: Run 'dockyard note %s \"text\"' to add one\n", projectName)
+				return
+			}
+			fmt.Printf("📝 %s: %s\n", projectName, note)
+		default:
+			if err := docker.SetNote(projectName, text); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+			fmt.Printf("✅ Note of project '%s' set to: %s\n", projectName, text)
+		}
+	},
+}
+
+// projectNoteSuffix returns the note of a project formatted to follow it on a line, empty without one
+func projectNoteSuffix(projectName string) string {
+	if note := docker.ProjectsSettings[projectName].Note; note != "" {
+		return " 📝 " + note
+	}
+	return ""
+}
+
+func init() {
+	noteCmd.Flags().BoolVar(&clearNote, "clear", false, "Remove the note")
+	rootCmd.AddCommand(noteCmd)
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var orphansCmd = &cobra.Command{
+	Use:   "orphans [project]",
+	Short: "List and remove unused volumes and images of a project",
+	Long:  `Report volumes of a project that have no attached container and images built for the project that are no longer referenced, and offer to remove them.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		orphans, err := cm.FindProjectOrphans(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to find orphans for project %s: %v\n", projectName, err)
+			return
+		}
+
+		if orphans.IsEmpty() {
+			fmt.Printf("✨ No orphaned volumes or images found for project '%s'\n", projectName)
+			return
+		}
+
+		safeVolumes, dataVolumes := splitOrphanVolumes(orphans.Volumes)
+		displayOrphans(projectName, safeVolumes, dataVolumes, orphans.Images)
+		removeProjectOrphans(cm, safeVolumes, dataVolumes, orphans.Images)
+	},
+}
+
+// splitOrphanVolumes separates empty volumes from volumes that may hold data
+func splitOrphanVolumes(volumes []docker.OrphanVolume) (safe, withData []docker.OrphanVolume) {
+	for _, vol := range volumes {
+		if vol.HasData() {
+			withData = append(withData, vol)
+		} else {
+			safe = append(safe, vol)
+		}
+	}
+	return safe, withData
+}
+
+func displayOrphans(projectName string, safeVolumes, dataVolumes []docker.OrphanVolume, images []docker.OrphanImage) {
+	fmt.Printf("🧹 Orphaned resources for project '%s':\n\n", projectName)
+
+	if len(safeVolumes) > 0 || len(images) > 0 {
+		fmt.Println(ui.RenderSuccess("Safe to remove"))
+		for _, vol := range safeVolumes {
+			fmt.Printf("   📦 volume %s (empty)\n", vol.Name)
+		}
+		for _, image := range images {
+			fmt.Printf("   🖼️  image %s %s (%s)\n", docker.ShortID(image.ID), strings.Join(image.Tags, ", "), docker.FormatBytes(image.Size))
+		}
+		fmt.Println()
+	}
+
+	if len(dataVolumes) > 0 {
+		fmt.Println(ui.RenderWarning("Contains data"))
+		for _, vol := range dataVolumes {
+			size := "unknown size"
+			if vol.Size > 0 {
+				size = docker.FormatBytes(vol.Size)
+			}
+			fmt.Printf("   💾 volume %s (%s)\n", vol.Name, size)
+		}
+		fmt.Println()
+	}
+}
+
+func removeProjectOrphans(cm *docker.ComposeManager, safeVolumes, dataVolumes []docker.OrphanVolume, images []docker.OrphanImage) {
+	if len(safeVolumes) > 0 || len(images) > 0 {
+		var confirm bool
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Remove %d empty volume(s) and %d unused image(s)?", len(safeVolumes), len(images)),
+			Default: false,
+		}
+		if err := survey.AskOne(prompt, &confirm); err != nil {
+			return
+		}
+
+		if confirm {
+			for _, vol := range safeVolumes {
+				reportRemoval("volume "+vol.Name, cm.RemoveVolume(vol.Name))
+			}
+			for _, image := range images {
+				reportRemoval("image "+docker.ShortID(image.ID), cm.RemoveImage(image.ID))
+			}
+		}
+	}
+
+	if len(dataVolumes) == 0 {
+		return
+	}
+
+	options := make([]string, len(dataVolumes))
+	for i, vol := range dataVolumes {
+		options[i] = vol.Name
+	}
+
+	var selected []string
+	prompt := &survey.MultiSelect{
+		Message: "⚠️  Select volumes WITH DATA to permanently delete (leave empty to keep all):",
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &selected); err != nil || len(selected) == 0 {
+		return
+	}
+
+	var confirm bool
+	confirmPrompt := &survey.Confirm{
+		Message: fmt.Sprintf("This permanently deletes the data in %s. Are you sure?", strings.Join(selected, ", ")),
+		Default: false,
+	}
+	if err := survey.AskOne(confirmPrompt, &confirm); err != nil || !confirm {
+		fmt.Println("👍 Volumes with data were kept.")
+		return
+	}
+
+	for _, name := range selected {
+		reportRemoval("volume "+name, cm.RemoveVolume(name))
+	}
+}
+
+func reportRemoval(resource string, err error) {
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Printf("🗑️  Removed %s\n", resource)
+}
+
+func init() {
+	rootCmd.AddCommand(orphansCmd)
+}
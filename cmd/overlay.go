@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var overlayCheck bool
+
+var overlayCmd = &cobra.Command{
+	Use:   "overlay [project] [overlay...]",
+	Short: "Start a project with overlays from the library",
+	Long: `Start a project with its compose file plus one or more overlays, reusable partial compose files such as
+"expose-debug-port" or "mount-local-src", so common dev modifications can be toggled without editing the project.
+Overlays are stored as <name>.yaml in the overlays directory of the dockyard config directory, see 'dockyard overlay list'.
+They are merged in the given order and checked to merge cleanly before starting. Use --check to only check them.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		var overlays []docker.Overlay
+		var paths []string
+		for _, name := range args[1:] {
+			overlay, err := docker.FindOverlay(name)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				fmt.Println("💡 Run 'dockyard overlay list' to see the available overlays")
+				setExitCode(ExitFailure)
+				return
+			}
+			overlays = append(overlays, overlay)
+			paths = append(paths, overlay.Path)
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		if err := cm.ValidateOverlays(projectDir, overlays); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Printf("✅ %d overlay(s) merge cleanly over project %s\n", len(overlays), projectName)
+		if overlayCheck {
+			return
+		}
+
+		applyProjectTimeout(cm, projectName)
+
+		err = cm.StartProject(projectDir, docker.StartOptions{
+			Detached:      true,
+			RemoveOrphans: true,
+			Scale:         docker.ProjectsSettings[projectName].Scale,
+			Overlays:      paths,
+		})
+		reportOperation(projectName, "start", err)
+		if err != nil {
+			fmt.Printf("Failed to start project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("✅ Project %s started with overlay(s): %s\n", projectName, strings.Join(args[1:], ", "))
+		fmt.Printf("💡 Run 'dockyard start %s' to go back to the plain project\n", projectName)
+		applyPendingLimits(cm, projectName, projectDir)
+	},
+}
+
+var overlayListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the overlays of the library",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		overlays, err := docker.ListOverlays()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(overlays) == 0 {
+			dir, _ := docker.OverlaysDir()
+			fmt.Println("📭 No overlays found")
+			fmt.Printf("💡 Tip: Save partial compose files as <name>.yaml in %s\n", dir)
+			return
+		}
+
+		for _, overlay := range overlays {
+			fmt.Printf("🧩 %-25s %s\n", overlay.Name, overlay.Description)
+		}
+	},
+}
+
+func init() {
+	overlayCmd.Flags().BoolVar(&overlayCheck, "check", false, "Only check that the overlays merge cleanly, don't start the project")
+	overlayCmd.AddCommand(overlayListCmd)
+	rootCmd.AddCommand(overlayCmd)
+}
@@ -14,12 +14,11 @@ var pauseCmd = &cobra.Command{
 	Long:  `Pause all running containers in a Docker project`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
@@ -55,12 +54,11 @@ var unpauseCmd = &cobra.Command{
 	Long:  `Unpause all paused containers in a Docker project`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
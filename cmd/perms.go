@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"runtime"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var fixPerms bool
+
+var permsCmd = &cobra.Command{
+	Use:   "perms [project]",
+	Short: "Check bind mount permissions against the container user",
+	Long: `Inspect the bind mount sources of a project and warn when their owner and mode would deny access to the user the container runs as, a common cause of "permission denied" at startup.
+Services running as root, or as a user name that cannot be mapped to a uid, are skipped. On Linux, --fix offers to chown or chmod each path after confirmation.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if fixPerms && runtime.GOOS != "linux" {
+			fmt.Println("❌ --fix is only supported on Linux")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		problems, err := cm.CheckMountPermissions(project)
+		if err != nil {
+			fmt.Printf("❌ Failed to check permissions: %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(problems) == 0 {
+			fmt.Println(ui.RenderSuccess(fmt.Sprintf("No bind mount permission problems found in %s", projectName)))
+			return
+		}
+
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("%d bind mount(s) in %s are likely to cause permission errors:", len(problems), projectName)))
+		fmt.Println()
+		for _, problem := range problems {
+			fmt.Printf("📁 %s (service %s)\n", problem.Path, problem.Service)
+			fmt.Printf("   owner %d:%d, mode %s, container user %s\n", problem.UID, problem.GID, problem.Mode.Perm(), problem.User)
+			fmt.Printf("   %s\n", problem.Problem)
+			fmt.Printf("   💡 %s\n", permsFixDescription(problem))
+
+			if fixPerms {
+				fixMountPermission(problem)
+			}
+			fmt.Println()
+		}
+
+		if !fixPerms {
+			setExitCode(ExitFailure)
+		}
+	},
+}
+
+// permsFixDescription describes the suggested fix as a shell command
+func permsFixDescription(problem docker.MountPermission) string {
+	chmod := fmt.Sprintf("chmod %o %s", problem.FixMode, problem.Path)
+	if problem.FixUID < 0 {
+		return chmod
+	}
+	chown := fmt.Sprintf("sudo chown %d:%d %s", problem.FixUID, problem.FixGID, problem.Path)
+	if problem.FixMode == problem.Mode.Perm() {
+		return chown
+	}
+	return chown + " && sudo " + chmod
+}
+
+// fixMountPermission applies the suggested fix once the user confirms it
+func fixMountPermission(problem docker.MountPermission) {
+	apply := false
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("Run '%s'?", permsFixDescription(problem)),
+		Default: false,
+	}
+	if err := survey.AskOne(prompt, &apply); err != nil || !apply {
+		return
+	}
+
+	if err := docker.FixMountPermission(problem); err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+	fmt.Println("   ✅ Fixed")
+}
+
+func init() {
+	permsCmd.Flags().BoolVar(&fixPerms, "fix", false, "Offer to chown or chmod each problematic path (Linux only)")
+	rootCmd.AddCommand(permsCmd)
+}
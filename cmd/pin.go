@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var pinCmd = &cobra.Command{
+	Use:   "pin [project]",
+	Short: "Pin the images a project is currently running",
+	Long:  `Record the image of each running service of a project in projects.json, so 'dockyard verify' can later detect drift.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, projectDir, cm, ok := openPinnedProject(args[0])
+		if !ok {
+			return
+		}
+		defer cm.Close()
+
+		images, err := cm.GetRunningImages(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get images for project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(images) == 0 {
+			fmt.Printf("📭 No running containers found for project '%s'\n", projectName)
+			fmt.Printf("💡 Tip: Run 'dockyard start %s' before pinning its images\n", projectName)
+			return
+		}
+
+		pinImages(projectName, images)
+	},
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify [project]",
+	Short: "Check that running containers match the pinned images",
+	Long:  `Compare the image of each running service of a project against the images recorded by 'dockyard pin', flagging drift and offering to re-pin.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, projectDir, cm, ok := openPinnedProject(args[0])
+		if !ok {
+			return
+		}
+		defer cm.Close()
+
+		pins := docker.ProjectsSettings[projectName].Pins
+		if len(pins) == 0 {
+			fmt.Printf("📌 Project '%s' has no pinned images\n", projectName)
+			fmt.Printf("💡 Tip: Run 'dockyard pin %s' to pin the images it is running\n", projectName)
+			return
+		}
+
+		running, err := cm.GetRunningImages(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get images for project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		checks := docker.VerifyPins(pins, running)
+		drifted := displayPinChecks(projectName, checks)
+		if drifted == 0 {
+			fmt.Println(ui.RenderSuccess("All running services match their pinned images"))
+			return
+		}
+
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("%d service(s) drifted from their pinned images", drifted)))
+		setExitCode(ExitFailure)
+
+		var repin bool
+		prompt := &survey.Confirm{
+			Message: "Re-pin the images currently running (e.g. after a deliberate upgrade)?",
+			Default: false,
+		}
+		if err := survey.AskOne(prompt, &repin); err != nil || !repin {
+			return
+		}
+
+		if pinImages(projectName, running) {
+			setExitCode(ExitSuccess)
+		}
+	},
+}
+
+// openPinnedProject resolves a project and opens a compose manager for it
+func openPinnedProject(name string) (string, string, *docker.ComposeManager, bool) {
+	projectName, ok := resolveProjectName(name)
+	if !ok {
+		return "", "", nil, false
+	}
+	projectPath := docker.Projects[projectName]
+
+	projectDir, err := utils.ResolveHomeDir(projectPath)
+	if err != nil {
+		fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+		setExitCode(ExitFailure)
+		return "", "", nil, false
+	}
+
+	if err := docker.CheckDockerStatus(); err != nil {
+		fmt.Printf("❌ Docker status check failed: %v\n", err)
+		setExitCodeForError(err)
+		return "", "", nil, false
+	}
+
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Printf("Failed to create compose manager: %v\n", err)
+		setExitCodeForError(err)
+		return "", "", nil, false
+	}
+
+	return projectName, projectDir, cm, true
+}
+
+func pinImages(projectName string, images map[string]string) bool {
+	if err := docker.PinProject(projectName, images); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return false
+	}
+
+	services := make([]string, 0, len(images))
+	for service := range images {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	fmt.Printf("📌 Pinned %d image(s) for project '%s':\n", len(images), projectName)
+	for _, service := range services {
+		fmt.Printf("   %-25s %s\n", service, docker.ShortID(images[service]))
+	}
+	return true
+}
+
+// displayPinChecks prints the pin comparison table and returns the number of drifted services
+func displayPinChecks(projectName string, checks []docker.PinCheck) int {
+	fmt.Printf("📌 Pinned images for project '%s':\n", projectName)
+	fmt.Printf("   %-25s %-14s %-14s %s\n", "SERVICE", "PINNED", "RUNNING", "STATUS")
+	fmt.Println(strings.Repeat("-", 75))
+
+	drifted := 0
+	for _, check := range checks {
+		marker := "  "
+		switch check.Status {
+		case docker.PinMatch:
+			marker = "✅"
+		case docker.PinDrift:
+			marker = "⚠️"
+			drifted++
+		case docker.PinNotRunning:
+			marker = "⏹️"
+		}
+
+		fmt.Printf("%s %-25s %-14s %-14s %s\n",
+			marker,
+			check.Service,
+			shortIDOrDash(check.Pinned),
+			shortIDOrDash(check.Running),
+			check.Status)
+	}
+	fmt.Println()
+
+	return drifted
+}
+
+func shortIDOrDash(id string) string {
+	if id == "" {
+		return "-"
+	}
+	return docker.ShortID(id)
+}
+
+func init() {
+	rootCmd.AddCommand(pinCmd)
+	rootCmd.AddCommand(verifyCmd)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var planCmd = &cobra.Command{
+	Use:   "plan [project]",
+	Short: "Preview the images a pull of a project would fetch",
+	Long: `List the images a project uses, as reported by 'docker compose config --images', and show which are
+already present locally, which would be pulled and from which registry, and which are built. Nothing is pulled,
+so this is a safe preview before pulling on a metered connection. Private registries without stored
+credentials are flagged like in 'dockyard registries'.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		authenticated, err := docker.AuthenticatedRegistries()
+		if err != nil {
+			fmt.Printf("⚠️  Could not read stored credentials: %v\n", err)
+		}
+
+		plan, err := cm.PlanPull(projectDir, authenticated)
+		if err != nil {
+			fmt.Printf("❌ Failed to plan pull of project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(plan) == 0 {
+			fmt.Printf("📭 Project '%s' uses no images\n", projectName)
+			return
+		}
+
+		displayPullPlan(projectName, plan, authenticated != nil)
+	},
+}
+
+// displayPullPlan prints the images of a project as a checklist, checked when nothing has to be fetched
+func displayPullPlan(projectName string, plan []docker.PlannedImage, credentialsKnown bool) {
+	fmt.Printf("📋 Pull plan for project '%s':\n", projectName)
+
+	var toPull, toBuild, present, needsLogin int
+	registries := make(map[string]bool)
+	for _, image := range plan {
+		var box, action string
+		switch {
+		case image.Build:
+			box, action = "[~]", "will build"
+			toBuild++
+		case image.Present:
+			box, action = "[x]", "present locally"
+			present++
+		default:
+			box, action = "[ ]", "pull from "+image.Registry
+			toPull++
+			registries[image.Registry] = true
+			if image.Private && !image.Authenticated && credentialsKnown {
+				action += " 🔐 not logged in"
+				needsLogin++
+			}
+		}
+
+		services := ""
+		if len(image.Services) > 0 {
+			services = fmt.Sprintf("(%s)", strings.Join(image.Services, ", "))
+		}
+		fmt.Printf("   %s %-45s %-30s %s\n", box, image.Image, action, services)
+	}
+
+	fmt.Printf("\n📊 %d to pull from %d registry(ies), %d to build, %d present locally\n", toPull, len(registries), toBuild, present)
+	if needsLogin > 0 {
+		fmt.Printf("💡 %d image(s) come from private registries without credentials, run: docker login <registry>\n", needsLogin)
+	}
+	if toPull > 0 {
+		fmt.Printf("💡 Run 'dockyard pull %s' to fetch them\n", projectName)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(planCmd)
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var procsCmd = &cobra.Command{
+	Use:   "procs [project]",
+	Short: "Show the processes running inside each container",
+	Long:  `Run docker compose top for a project to list the processes running inside each service container, e.g. to check that a supervisor spawned its children. Services that are not running are listed first.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		if err := cm.ProcessList(projectDir); err != nil {
+			fmt.Printf("Failed to list processes of project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(procsCmd)
+}
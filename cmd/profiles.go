@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var (
+	profilesList bool
+	profilesSave bool
+)
+
+var profilesCmd = &cobra.Command{
+	Use:   "profiles [project]",
+	Short: "List compose profiles and start a project with selected ones",
+	Long: `List the profiles declared by the services of a project with the services each one enables.
+On a terminal, select the profiles to activate and the project is started with them, along with the services that have no profile. Use --save to remember the selection as the default of the next run, or --list to only list the profiles.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		profiles := docker.ProjectProfiles(project)
+		if len(profiles) == 0 {
+			fmt.Printf("📭 Project '%s' declares no profiles\n", projectName)
+			return
+		}
+
+		names := slices.Sorted(maps.Keys(profiles))
+		fmt.Printf("🎛️  Profiles of project '%s':\n", projectName)
+		for _, name := range names {
+			fmt.Printf("   %-20s %s\n", name, strings.Join(profiles[name], ", "))
+		}
+		fmt.Println()
+
+		if profilesList || !docker.IsInteractiveTerminal() {
+			return
+		}
+
+		selected, ok := selectProfiles(projectName, names)
+		if !ok {
+			return
+		}
+
+		if profilesSave {
+			if err := docker.SaveProfiles(projectName, selected); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+			fmt.Printf("💾 Saved the selected profiles of project %s\n", projectName)
+		}
+
+		startWithProfiles(cm, projectName, projectDir, selected)
+	},
+}
+
+// selectProfiles asks which profiles to activate, preselecting the saved ones still declared
+func selectProfiles(projectName string, names []string) ([]string, bool) {
+	var defaults []string
+	for _, profile := range docker.ProjectsSettings[projectName].Profiles {
+		if slices.Contains(names, profile) {
+			defaults = append(defaults, profile)
+		}
+	}
+
+	var selected []string
+	prompt := &survey.MultiSelect{
+		Message: "Select the profiles to start with:",
+		Options: names,
+		Default: defaults,
+	}
+	if err := survey.AskOne(prompt, &selected); err != nil {
+		return nil, false
+	}
+	return selected, true
+}
+
+// startWithProfiles starts the project in the background with the given profiles active
+func startWithProfiles(cm *docker.ComposeManager, projectName, projectDir string, profiles []string) {
+	applyProjectTimeout(cm, projectName)
+
+	if len(profiles) == 0 {
+		fmt.Println("💡 No profile selected, only the services without a profile are started")
+	}
+	err := cm.StartProject(projectDir, docker.StartOptions{
+		Detached:      true,
+		RemoveOrphans: true,
+		Scale:         docker.ProjectsSettings[projectName].Scale,
+		Profiles:      profiles,
+	})
+	reportOperation(projectName, "start", err)
+	if err != nil {
+		fmt.Printf("Failed to start project %s: %v\n", projectName, err)
+		setExitCodeForError(err)
+		return
+	}
+
+	fmt.Printf("✅ Project %s started successfully!\n", projectName)
+	applyPendingLimits(cm, projectName, projectDir)
+}
+
+func init() {
+	profilesCmd.Flags().BoolVar(&profilesList, "list", false, "Only list the profiles, don't start the project")
+	profilesCmd.Flags().BoolVar(&profilesSave, "save", false, "Remember the selected profiles as the default selection")
+	rootCmd.AddCommand(profilesCmd)
+}
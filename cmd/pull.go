@@ -14,22 +14,23 @@ var pullCmd = &cobra.Command{
 	Long:  `Pull service images for a Docker project`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
 			return
 		}
 
 		cm, err := docker.NewComposeManager()
 		if err != nil {
 			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
 			return
 		}
 		defer func(cm *docker.ComposeManager) {
@@ -41,9 +42,13 @@ var pullCmd = &cobra.Command{
 			}
 		}(cm)
 
+		applyProjectTimeout(cm, projectName)
+
 		err = cm.PullImages(projectDir)
+		reportOperation(projectName, "pull", err)
 		if err != nil {
 			fmt.Printf("Failed to pull images for project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
 			return
 		}
 	},
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/spf13/cobra"
+)
+
+var registriesCmd = &cobra.Command{
+	Use:   "registries [project]",
+	Short: "Show which registries a project's images come from",
+	Long: `Group the images of a project's services by the registry they are pulled from, defaulting to
+docker.io for images without a registry host. Registries other than Docker Hub are flagged as
+private, and each registry shows whether docker has credentials stored for it, so the logins a
+project needs are known before starting it.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		authenticated, err := docker.AuthenticatedRegistries()
+		if err != nil {
+			fmt.Printf("⚠️  Could not read stored credentials: %v\n", err)
+		}
+
+		registries := docker.ProjectRegistries(project, authenticated)
+		if len(registries) == 0 {
+			fmt.Printf("📭 Project '%s' pulls no images, all services are built locally\n", projectName)
+			return
+		}
+
+		displayRegistries(projectName, registries, authenticated != nil)
+	},
+}
+
+// displayRegistries prints the images of each registry and whether credentials are stored for it
+func displayRegistries(projectName string, registries []docker.RegistryImages, credentialsKnown bool) {
+	fmt.Printf("🌐 Registries used by project '%s':\n", projectName)
+
+	needsLogin := 0
+	for _, registry := range registries {
+		status := "public"
+		switch {
+		case registry.Authenticated:
+			status = "🔓 logged in"
+		case registry.Private && credentialsKnown:
+			status = "🔐 private, not logged in"
+			needsLogin++
+		case registry.Private:
+			status = "🔐 private"
+		}
+
+		fmt.Printf("\n%s (%s)\n", registry.Registry, status)
+		for _, service := range slices.Sorted(maps.Keys(registry.Images)) {
+			fmt.Printf("   %-20s %s\n", service, registry.Images[service])
+		}
+	}
+
+	if needsLogin > 0 {
+		fmt.Printf("\n💡 Private registries without credentials: %d, run: docker login <registry>\n", needsLogin)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(registriesCmd)
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var (
+	resetNoStart bool
+	resetForce   bool
+)
+
+var resetCmd = &cobra.Command{
+	Use:   "reset [project]",
+	Short: "Reset a project to a clean slate",
+	Long: `Take a project down with its volumes and orphan containers, remove the images it built and its unused
+build cache, then pull, build and start it again from scratch. Volumes are deleted, so their data is lost.
+Pulled images and images with a custom name are kept, since other projects may use them.
+The project name must be typed to confirm, --force skips the confirmation.
+Use --no-start to only clean the project without starting it again.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		plan, err := cm.PlanReset(projectDir)
+		if err != nil {
+			fmt.Printf("❌ Failed to inspect project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		printResetPlan(projectName, plan)
+
+		if !resetForce && !confirmReset(projectName) {
+			fmt.Println("👍 Project was left untouched.")
+			return
+		}
+
+		applyProjectTimeout(cm, projectName)
+
+		err = cm.ResetProject(projectDir, plan)
+		reportOperation(projectName, "reset", err)
+		if err != nil {
+			fmt.Printf("Failed to reset project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if resetNoStart {
+			fmt.Printf("💡 Run 'dockyard start %s' to start it again\n", projectName)
+			return
+		}
+
+		if err := cm.PullImages(projectDir); err != nil {
+			fmt.Printf("Failed to pull images for project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+		if plan.HasBuild {
+			if err := cm.BuildImages(projectDir, false, docker.BuildProgressAuto); err != nil {
+				fmt.Printf("Failed to build images for project %s: %v\n", projectName, err)
+				setExitCodeForError(err)
+				return
+			}
+		}
+
+		err = cm.StartProject(projectDir, docker.StartOptions{
+			Detached:      true,
+			RemoveOrphans: true,
+			Scale:         docker.ProjectsSettings[projectName].Scale,
+		})
+		reportOperation(projectName, "start", err)
+		if err != nil {
+			fmt.Printf("Failed to start project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("✅ Project %s started fresh!\n", projectName)
+		applyPendingLimits(cm, projectName, projectDir)
+	},
+}
+
+// printResetPlan lists everything a reset destroys
+func printResetPlan(projectName string, plan *docker.ResetPlan) {
+	fmt.Printf("🧨 Resetting project '%s' destroys:\n", projectName)
+
+	fmt.Printf("   Containers (%d):\n", len(plan.Containers))
+	for _, container := range plan.Containers {
+		fmt.Printf("      • %s\n", container)
+	}
+
+	fmt.Printf("   Volumes (%d), their data is lost:\n", len(plan.Volumes))
+	for _, volume := range plan.Volumes {
+		size := "unknown size"
+		if volume.Size >= 0 {
+			size = docker.FormatBytes(volume.Size)
+		}
+		fmt.Printf("      • %s (%s)\n", volume.Name, size)
+	}
+
+	fmt.Printf("   Built images (%d), pulled images are kept:\n", len(plan.Images))
+	for _, image := range plan.Images {
+		fmt.Printf("      • %s (%s)\n", image.Image, image.Service)
+	}
+
+	if plan.BuildCache != nil {
+		ids := plan.BuildCache.RecordIDs()
+		fmt.Printf("   Build cache: %d record(s), %s\n", len(ids), docker.FormatBytes(plan.BuildCache.Reclaimable))
+	}
+
+	if !resetNoStart {
+		fmt.Println("   The project is then pulled, built and started again.")
+	}
+	fmt.Println()
+}
+
+// confirmReset asks the user to type the project name, since a reset loses volume data
+func confirmReset(projectName string) bool {
+	if !docker.IsInteractiveTerminal() {
+		fmt.Println("❌ Refusing to reset without a terminal to confirm, use --force")
+		setExitCode(ExitFailure)
+		return false
+	}
+
+	var answer string
+	prompt := &survey.Input{
+		Message: fmt.Sprintf("Type '%s' to confirm the reset:", projectName),
+	}
+	if err := survey.AskOne(prompt, &answer); err != nil {
+		return false
+	}
+	return answer == projectName
+}
+
+func init() {
+	resetCmd.Flags().BoolVar(&resetNoStart, "no-start", false, "Only clean the project, don't pull, build and start it again")
+	resetCmd.Flags().BoolVar(&resetForce, "force", false, "Reset without asking for confirmation")
+	rootCmd.AddCommand(resetCmd)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var resolveCmd = &cobra.Command{
+	Use:   "resolve [project]",
+	Short: "Show the compose config with anchors and extends expanded",
+	Long: `Print, for each service, the fields it declares itself and the fields it inherits from YAML merge
+anchors or extends, followed by the fully resolved compose configuration.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		resolutions, err := docker.ResolveInheritance(projectDir, project)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		config, err := docker.ResolvedConfigYAML(project)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Print(ui.RenderMarkdown(resolutionMarkdown(projectName, resolutions, config)))
+	},
+}
+
+// resolutionMarkdown renders the inheritance of each service and the resolved config as markdown
+func resolutionMarkdown(projectName string, resolutions []docker.ServiceResolution, config string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Resolved config of %s\n\n", projectName)
+
+	for _, resolution := range resolutions {
+		fmt.Fprintf(&b, "## %s\n\n", resolution.Service)
+		if len(resolution.Own) > 0 {
+			fmt.Fprintf(&b, "- **declared:** %s\n", strings.Join(resolution.Own, ", "))
+		}
+		for _, origin := range resolution.Inherited {
+			if origin.Source == docker.OriginDefault {
+				continue
+			}
+			fmt.Fprintf(&b, "- **inherited:** `%s` from `%s`\n", origin.Field, origin.Source)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Expanded configuration\n\n```yaml\n")
+	b.WriteString(config)
+	b.WriteString("```\n")
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(resolveCmd)
+}
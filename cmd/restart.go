@@ -4,32 +4,48 @@ import (
 	"dockyard/pkg/docker"
 	"dockyard/pkg/utils"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
+var (
+	restartNoDeps  bool
+	restartTimeout time.Duration
+)
+
 var restartCmd = &cobra.Command{
-	Use:   "restart [project]",
+	Use:   "restart [project] [service...]",
 	Short: "Restart a Docker project",
-	Long:  `Restart all services in a Docker project`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Restart all services in a Docker project, or only the given services.
+Use --no-deps with specific services to restart only them and not the services depending on them.
+Use --timeout to change the grace period before stopping containers are killed, e.g. --timeout 0s to bounce a service immediately.
+Restarting does not recreate containers, so compose file changes are not applied; use 'dockyard start --force' for that.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		services := args[1:]
+		options := docker.RestartOptions{NoDeps: restartNoDeps}
+		if cmd.Flags().Changed("timeout") {
+			options.Timeout = &restartTimeout
+		}
+
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
 			return
 		}
 
 		cm, err := docker.NewComposeManager()
 		if err != nil {
 			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
 			return
 		}
 		defer func(cm *docker.ComposeManager) {
@@ -41,14 +57,25 @@ var restartCmd = &cobra.Command{
 			}
 		}(cm)
 
-		err = cm.RestartProject(projectDir)
+		applyProjectTimeout(cm, projectName)
+
+		if len(services) > 0 {
+			err = cm.RestartServices(projectDir, services, options)
+		} else {
+			err = cm.RestartProject(projectDir, options)
+		}
+		reportOperation(projectName, "restart", err)
 		if err != nil {
 			fmt.Printf("Failed to restart project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
 			return
 		}
+		applyPendingLimits(cm, projectName, projectDir)
 	},
 }
 
 func init() {
+	restartCmd.Flags().BoolVar(&restartNoDeps, "no-deps", false, "Don't restart the services depending on the given services")
+	restartCmd.Flags().DurationVar(&restartTimeout, "timeout", 0, "Grace period before stopping containers are killed (default: compose's)")
 	rootCmd.AddCommand(restartCmd)
 }
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var rollTimeout time.Duration
+
+var rollCmd = &cobra.Command{
+	Use:   "roll [project] [service]",
+	Short: "Recreate a service's replicas one at a time",
+	Long: `Recreate the replicas of a scaled service one at a time to pick up a new image or configuration while the others keep serving.
+For each replica a new one is started, and once it is running (healthy when it has a healthcheck) the old one is stopped and removed. A replica that fails to come up is removed and the roll stops, keeping the remaining old replicas.
+A service with a single replica or a fixed container_name is recreated in place with a brief downtime. Replicas publishing a fixed host port cannot run side by side, so scale such services without a fixed port first.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+		service := args[1]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		applyProjectTimeout(cm, projectName)
+
+		err = cm.RollService(projectDir, service, rollTimeout)
+		reportOperation(projectName, "roll", err)
+		if err != nil {
+			fmt.Printf("❌ Failed to roll service %s: %v\n", service, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("✅ Service %s of project %s was rolled\n", service, projectName)
+		applyPendingLimits(cm, projectName, projectDir)
+	},
+}
+
+func init() {
+	rollCmd.Flags().DurationVar(&rollTimeout, "wait-timeout", 2*time.Minute, "Maximum time to wait for each new replica to become ready")
+	rootCmd.AddCommand(rollCmd)
+}
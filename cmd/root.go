@@ -3,9 +3,12 @@ package cmd
 import (
 	"dockyard/pkg/docker"
 	"dockyard/pkg/utils"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -24,6 +27,15 @@ type result struct {
 	err         error
 }
 
+// assumeYes accepts suggested answers without prompting
+var assumeYes bool
+
+// projectDirOverride and composeFileOverride replace the targeted project's directory and compose file
+var (
+	projectDirOverride  string
+	composeFileOverride string
+)
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:              "dockyard",
@@ -40,11 +52,30 @@ func handlePersistentPreRun(cmd *cobra.Command, args []string) {
 		fmt.Println(err)
 		os.Exit(1)
 	}
+
+	if err := docker.LoadRegistryPatterns("registries.yaml"); err != nil {
+		fmt.Printf("⚠️  Ignoring custom registry patterns: %v\n", err)
+	}
+
+	if err := utils.SetProjectOverrides(projectDirOverride, composeFileOverride); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+	if utils.HasProjectOverrides() && !targetsSingleProject(cmd, args) {
+		fmt.Printf("❌ --project-dir and --file apply to a single project, '%s' works on several\n", cmd.CommandPath())
+		os.Exit(1)
+	}
+}
+
+// targetsSingleProject reports whether a command works on the one project named as its first argument
+func targetsSingleProject(cmd *cobra.Command, args []string) bool {
+	usage := strings.Fields(cmd.Use)
+	return len(usage) > 1 && usage[1] == "[project]" && len(args) > 0
 }
 
 // handlePreRun displays project information
 func handlePreRun(cmd *cobra.Command, args []string) {
-	utils.ProjectInfo()
+	utils.ProjectInfo(docker.CurrentContext())
 }
 
 // handleRootCommand is the main entry point for the root command
@@ -113,8 +144,18 @@ func (r *projectRunner) startSingleProject(projectName string) result {
 
 	fmt.Printf("📦 Starting project: %s\n", projectName)
 	err = executeWithComposeManager(projectDir, func(cm *docker.ComposeManager) error {
-		return cm.StartProject(projectDir, true, true) // detached=true, removeOrphans=true
+		applyProjectTimeout(cm, projectName)
+		if err := cm.StartProject(projectDir, docker.StartOptions{
+			Detached:      true,
+			RemoveOrphans: true,
+			Scale:         docker.ProjectsSettings[projectName].Scale,
+		}); err != nil {
+			return err
+		}
+		applyPendingLimits(cm, projectName, projectDir)
+		return nil
 	})
+	reportOperation(projectName, "start", err)
 
 	return result{
 		projectName: projectName,
@@ -154,7 +195,9 @@ func (r *projectRunner) offerRetry() {
 
 // showFinalStatus displays the final status or helpful tips
 func (r *projectRunner) showFinalStatus(selectedProjects []string) {
-	if r.successCount > 0 {
+	if r.successCount > 0 && startStatus {
+		r.showStartedProjectsStatus(selectedProjects)
+	} else if r.successCount > 0 {
 		fmt.Println("\n📈 Current project status:")
 		showStatusForProjects(selectedProjects)
 	} else if len(r.failedProjects) > 0 {
@@ -162,6 +205,30 @@ func (r *projectRunner) showFinalStatus(selectedProjects []string) {
 	}
 }
 
+// showStartedProjectsStatus prints the containers of each project that started successfully
+func (r *projectRunner) showStartedProjectsStatus(selectedProjects []string) {
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Printf("Failed to create compose manager: %v\n", err)
+		return
+	}
+	defer cm.Close()
+
+	time.Sleep(startStatusDelay)
+	for _, projectName := range selectedProjects {
+		if slices.Contains(r.failedProjects, projectName) {
+			continue
+		}
+
+		projectDir, err := utils.ResolveHomeDir(docker.Projects[projectName])
+		if err != nil {
+			fmt.Printf("❌ %s: Failed to resolve path\n", projectName)
+			continue
+		}
+		showStatusAfterStart(cm, projectName, projectDir)
+	}
+}
+
 // executeWithComposeManager creates a compose manager, executes the function, and ensures proper cleanup
 func executeWithComposeManager(projectDir string, fn func(*docker.ComposeManager) error) error {
 	cm, err := docker.NewComposeManager()
@@ -177,18 +244,74 @@ func executeWithComposeManager(projectDir string, fn func(*docker.ComposeManager
 	return fn(cm)
 }
 
+// applyProjectTimeout uses the timeout configured for the project in projects.json unless
+// --op-timeout was given
+func applyProjectTimeout(cm *docker.ComposeManager, projectName string) {
+	if docker.OperationTimeout > 0 {
+		return
+	}
+
+	timeout, err := docker.ProjectsSettings[projectName].OperationTimeout()
+	if err != nil {
+		fmt.Printf("⚠️  Ignoring timeout of project %s: %v\n", projectName, err)
+		return
+	}
+	cm.SetOperationTimeout(timeout)
+}
+
+// resolveProjectName returns the registered project matching name or one of its aliases. On a miss it prints
+// the closest project names and, with --yes and a single suggestion, uses that project.
+func resolveProjectName(name string) (string, bool) {
+	if _, ok := docker.Projects[name]; ok {
+		return targetProjectOverrides(name)
+	}
+	if projectName, ok := docker.ResolveAlias(name); ok {
+		return targetProjectOverrides(projectName)
+	}
+
+	fmt.Printf("Unknown project: %s\n", name)
+
+	suggestions := docker.SuggestProjectNames(name)
+	switch {
+	case len(suggestions) == 1 && assumeYes:
+		fmt.Printf("👉 Using project '%s'\n", suggestions[0])
+		return targetProjectOverrides(suggestions[0])
+	case len(suggestions) == 1:
+		fmt.Printf("💡 Did you mean '%s'?\n", suggestions[0])
+	case len(suggestions) > 1:
+		fmt.Printf("💡 Did you mean one of: %s?\n", strings.Join(suggestions, ", "))
+	}
+
+	setExitCode(ExitProjectNotFound)
+	return "", false
+}
+
+// targetProjectOverrides scopes the --project-dir and --file overrides to the resolved project
+func targetProjectOverrides(projectName string) (string, bool) {
+	if err := utils.SetOverrideTarget(docker.Projects[projectName]); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return "", false
+	}
+	return projectName, true
+}
+
 // isDaemonError checks if the error is related to Docker daemon connectivity
 func isDaemonError(err error) bool {
 	if err == nil {
 		return false
 	}
 
-	errorStr := err.Error()
+	if errors.Is(err, docker.ErrDaemonUnavailable) {
+		return true
+	}
+
+	errorStr := strings.ToLower(err.Error())
 	daemonErrors := []string{
-		"Docker daemon is not running",
-		"Cannot connect to the Docker daemon",
+		"docker daemon is not running",
+		"cannot connect to the docker daemon",
 		"connection refused",
-		"Docker is not accessible",
+		"docker is not accessible",
 	}
 
 	for _, daemonError := range daemonErrors {
@@ -299,10 +422,27 @@ func printRetryResults(successCount, totalRetried int, stillFailed []string) {
 	}
 }
 
+func init() {
+	rootCmd.Flags().BoolVar(&startStatus, "status", false, "Show the status of the started projects' containers")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "Proceed with suggested project names without asking")
+	rootCmd.PersistentFlags().StringVar(&webhookURL, "webhook", "", "POST operation results as JSON to this URL (default $DOCKYARD_WEBHOOK_URL)")
+	rootCmd.PersistentFlags().StringVar(&projectDirOverride, "project-dir", "", "Use this directory instead of the project's registered path")
+	rootCmd.PersistentFlags().StringVar(&composeFileOverride, "file", "", "Use this compose file instead of the one found in the project directory")
+	rootCmd.PersistentFlags().StringVar(&docker.APIVersion, "api-version", "", "Use this Docker API version instead of negotiating it with the daemon (default $DOCKER_API_VERSION)")
+	rootCmd.PersistentFlags().DurationVar(&docker.OperationTimeout, "op-timeout", 0, "Kill docker commands that run longer than this, e.g. 10m (default the project's timeout in projects.json, none otherwise)")
+	rootCmd.PersistentFlags().BoolVar(&utils.RefreshRemoteSources, "refresh", false, "Download remote compose sources again instead of using the cache")
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.Execute()
+	waitWebhooks()
+	for _, warning := range utils.RemoteSourceWarnings() {
+		fmt.Printf("⚠️  %s\n", warning)
+	}
+	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(ExitFailure)
 	}
+	os.Exit(exitCode)
 }
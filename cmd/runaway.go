@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+// Actions offered for runaway containers
+const (
+	runawayRestart = "Restart"
+	runawayStop    = "Stop"
+)
+
+var (
+	runawayCPU    float64
+	runawayMemory float64
+)
+
+var runawayCmd = &cobra.Command{
+	Use:   "runaway",
+	Short: "Find and tame containers using too much CPU or memory",
+	Long: `Sample the resource usage of every running compose container and list those above the CPU or memory threshold, with the project and service they belong to.
+CPU is measured against the container's CPU limit, or a single core when it has none. Memory is measured against the container's memory limit, or the host memory when it has none.
+The listed containers can then be restarted or stopped after confirmation.`,
+	Example: `  dockyard runaway
+  dockyard runaway --cpu 150 --mem 50`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if runawayCPU <= 0 || runawayMemory <= 0 {
+			fmt.Println("❌ Thresholds must be positive percentages")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		fmt.Println("🔍 Sampling container resource usage...")
+		runaways, err := cm.FindRunawayContainers(runawayCPU, runawayMemory)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(runaways) == 0 {
+			fmt.Printf("✅ No container above %.0f%% CPU or %.0f%% memory\n", runawayCPU, runawayMemory)
+			return
+		}
+
+		displayRunaways(runaways)
+		tameRunaways(cm, runaways)
+	},
+}
+
+// displayRunaways prints each runaway container with its owner and the usage over the threshold
+func displayRunaways(runaways []docker.RunawayContainer) {
+	fmt.Printf("🔥 %d container(s) above the thresholds:\n\n", len(runaways))
+	fmt.Printf("%-20s %-15s %-30s %8s %8s %10s\n", "PROJECT", "SERVICE", "CONTAINER", "CPU", "MEM", "MEM USED")
+	for _, runaway := range runaways {
+		project := runaway.Project
+		if !runaway.Registered {
+			project += " (unregistered)"
+		}
+		fmt.Printf("%-20s %-15s %-30s %8s %8s %10s\n",
+			project,
+			runaway.Service,
+			runaway.Name,
+			runawayPercent(runaway.CPUPercent, runaway.ExceedsCPU),
+			runawayPercent(runaway.MemoryPercent, runaway.ExceedsMemory),
+			docker.FormatBytes(runaway.MemoryUsage))
+	}
+	fmt.Println()
+}
+
+// runawayPercent formats a usage percentage, marking it when it exceeds the threshold
+func runawayPercent(percent float64, exceeds bool) string {
+	if exceeds {
+		return fmt.Sprintf("🔥%.0f%%", percent)
+	}
+	return fmt.Sprintf("%.0f%%", percent)
+}
+
+// tameRunaways lets the user pick runaway containers and restart or stop them once confirmed
+func tameRunaways(cm *docker.ComposeManager, runaways []docker.RunawayContainer) {
+	options := make([]string, len(runaways))
+	byOption := make(map[string]docker.RunawayContainer, len(runaways))
+	for i, runaway := range runaways {
+		options[i] = fmt.Sprintf("%s/%s (%s)", runaway.Project, runaway.Service, runaway.Name)
+		byOption[options[i]] = runaway
+	}
+
+	var selected []string
+	prompt := &survey.MultiSelect{
+		Message: "Select containers to restart or stop (leave empty to keep all):",
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &selected); err != nil || len(selected) == 0 {
+		return
+	}
+
+	var action string
+	actionPrompt := &survey.Select{
+		Message: "What should happen to them?",
+		Options: []string{runawayRestart, runawayStop},
+	}
+	if err := survey.AskOne(actionPrompt, &action); err != nil {
+		return
+	}
+
+	var confirm bool
+	confirmPrompt := &survey.Confirm{
+		Message: fmt.Sprintf("%s %d container(s)?", action, len(selected)),
+		Default: false,
+	}
+	if err := survey.AskOne(confirmPrompt, &confirm); err != nil || !confirm {
+		return
+	}
+
+	for _, option := range selected {
+		runaway := byOption[option]
+		var err error
+		done := "restarted"
+		if action == runawayStop {
+			err, done = cm.StopContainer(runaway.ID), "stopped"
+		} else {
+			err = cm.RestartContainer(runaway.ID)
+		}
+
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", runaway.Name, err)
+			setExitCode(ExitFailure)
+			continue
+		}
+		fmt.Printf("✅ %s %s\n", runaway.Name, done)
+	}
+}
+
+func init() {
+	runawayCmd.Flags().Float64Var(&runawayCPU, "cpu", docker.DefaultRunawayCPU, "CPU usage threshold in percent")
+	runawayCmd.Flags().Float64Var(&runawayMemory, "mem", docker.DefaultRunawayMemory, "Memory usage threshold in percent")
+	rootCmd.AddCommand(runawayCmd)
+}
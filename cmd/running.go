@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var quietRunning bool
+
+var runningCmd = &cobra.Command{
+	Use:   "running",
+	Short: "List projects with running containers",
+	Long: `List only the projects that have at least one running container, with their running and total service counts.
+Use --quiet to print just the project names, e.g. 'dockyard running -q | xargs -n1 dockyard stop'.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if !quietRunning {
+			if err := docker.CheckDockerStatus(); err != nil {
+				fmt.Printf("❌ Docker status check failed: %v\n", err)
+				setExitCodeForError(err)
+				return
+			}
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Fprintf(runningOutput(), "Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		projectNames := docker.GetSortedProjectNames()
+		results := fetchProjectStatuses(cm, projectNames, !quietRunning)
+
+		found := 0
+		for i, projectName := range projectNames {
+			if results[i].failure != "" {
+				fmt.Fprintf(runningOutput(), "❌ %s: %s\n", projectName, results[i].failure)
+				setExitCodeForError(results[i].err)
+				continue
+			}
+
+			running, total := countRunningServices(results[i].statuses)
+			if running == 0 {
+				continue
+			}
+			found++
+
+			if quietRunning {
+				fmt.Println(projectName)
+				continue
+			}
+			fmt.Printf("🟢 %-25s %d/%d services running\n", projectName, running, total)
+		}
+
+		if found == 0 && !quietRunning {
+			fmt.Println("💤 No project is running")
+		}
+	},
+}
+
+// runningOutput returns where errors are reported, stderr in quiet mode so that they are not
+// taken for project names
+func runningOutput() io.Writer {
+	if quietRunning {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// countRunningServices returns the number of services with at least one running container
+// and the total number of services that have containers
+func countRunningServices(statuses []docker.ContainerStatus) (int, int) {
+	services := make(map[string]bool)
+	for _, status := range statuses {
+		services[status.Service] = services[status.Service] || status.State == "running"
+	}
+
+	running := 0
+	for _, isRunning := range services {
+		if isRunning {
+			running++
+		}
+	}
+	return running, len(services)
+}
+
+func init() {
+	runningCmd.Flags().BoolVarP(&quietRunning, "quiet", "q", false, "Only print the names of running projects")
+	rootCmd.AddCommand(runningCmd)
+}
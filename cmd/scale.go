@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+var saveScale bool
+
+var scaleCmd = &cobra.Command{
+	Use:   "scale [project] [service] [replicas]",
+	Short: "Scale a service of a project",
+	Long: `Set the number of running replicas of a service.
+Use --save to store the count in projects.json so 'dockyard start' applies it automatically.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		service := args[1]
+		replicas, err := strconv.Atoi(args[2])
+		if err != nil || replicas < 0 {
+			fmt.Printf("❌ Invalid replica count: %s\n", args[2])
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if _, err := project.GetService(service); err != nil {
+			fmt.Printf("❌ Service %s not found in project %s\n", service, projectName)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := cm.ScaleService(projectDir, service, replicas); err != nil {
+			fmt.Printf("❌ Failed to scale service %s: %v\n", service, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if saveScale {
+			if err := docker.SaveScaleDefault(projectName, service, replicas); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				setExitCode(ExitFailure)
+				return
+			}
+			fmt.Printf("💾 Saved %d replica(s) as the default for %s in project %s\n", replicas, service, projectName)
+		}
+	},
+}
+
+func init() {
+	scaleCmd.Flags().BoolVar(&saveScale, "save", false, "Store the replica count as the project's default")
+	rootCmd.AddCommand(scaleCmd)
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var scanDepth int
+
+var scanCmd = &cobra.Command{
+	Use:   "scan [root]",
+	Short: "Find compose projects in a directory tree and register them",
+	Long: `Walk a directory tree, the current directory by default, down to --depth levels and list every directory
+with compose files that is not registered yet. The selected ones are registered in one step, named after their
+directory. Hidden directories and dependency folders such as node_modules are skipped. When a name is already
+taken, a new one is asked for.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if scanDepth < 1 {
+			fmt.Println("The --depth flag must be at least 1")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		root := "."
+		if len(args) == 1 {
+			root = args[0]
+		}
+
+		found, err := docker.ScanComposeProjects(root, scanDepth)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		var candidates []docker.ScannedProject
+		for _, project := range found {
+			if project.Registered != "" {
+				fmt.Printf("✅ %s is already registered as '%s'\n", project.Path, project.Registered)
+				continue
+			}
+			candidates = append(candidates, project)
+		}
+
+		if len(candidates) == 0 {
+			fmt.Printf("📭 No unregistered compose project found under %s\n", root)
+			return
+		}
+
+		selected, ok := selectScannedProjects(candidates)
+		if !ok || len(selected) == 0 {
+			fmt.Println("👍 No project was registered.")
+			return
+		}
+
+		projects, ok := nameScannedProjects(selected)
+		if !ok {
+			fmt.Println("👍 No project was registered.")
+			return
+		}
+
+		if err := docker.RegisterProjects(projects); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		names := slices.Sorted(maps.Keys(projects))
+		fmt.Printf("✅ Registered %d project(s): %s\n", len(projects), strings.Join(names, ", "))
+	},
+}
+
+// selectScannedProjects asks which of the found projects to register, all of them by default
+func selectScannedProjects(candidates []docker.ScannedProject) ([]docker.ScannedProject, bool) {
+	options := make([]string, len(candidates))
+	for i, project := range candidates {
+		options[i] = fmt.Sprintf("%s (%s)", project.Name, project.Path)
+	}
+
+	var chosen []int
+	prompt := &survey.MultiSelect{
+		Message: fmt.Sprintf("Found %d compose project(s), select the ones to register:", len(candidates)),
+		Options: options,
+		Default: options,
+	}
+	if err := survey.AskOne(prompt, &chosen); err != nil {
+		return nil, false
+	}
+
+	selected := make([]docker.ScannedProject, len(chosen))
+	for i, index := range chosen {
+		selected[i] = candidates[index]
+	}
+	return selected, true
+}
+
+// nameScannedProjects maps each selected project to its name, asking for another name when the
+// derived one is already registered or used by another selected project
+func nameScannedProjects(selected []docker.ScannedProject) (map[string]string, bool) {
+	projects := make(map[string]string, len(selected))
+	taken := func(name string) bool {
+		_, registered := docker.Projects[name]
+		_, chosen := projects[name]
+		return registered || chosen
+	}
+
+	for _, project := range selected {
+		name := project.Name
+		if taken(name) {
+			fmt.Printf("⚠️  The name '%s' is already taken, %s needs another one\n", name, project.Path)
+			prompt := &survey.Input{
+				Message: fmt.Sprintf("Name for %s:", project.Path),
+			}
+			validate := func(answer any) error {
+				value := strings.TrimSpace(answer.(string))
+				switch {
+				case value == "":
+					return fmt.Errorf("the name cannot be empty")
+				case taken(value):
+					return fmt.Errorf("the name '%s' is already taken", value)
+				}
+				return nil
+			}
+			if err := survey.AskOne(prompt, &name, survey.WithValidator(validate)); err != nil {
+				return nil, false
+			}
+			name = strings.TrimSpace(name)
+		}
+		projects[name] = project.Path
+	}
+	return projects, true
+}
+
+func init() {
+	scanCmd.Flags().IntVar(&scanDepth, "depth", docker.DefaultScanDepth, "Number of directory levels below the root to search")
+	rootCmd.AddCommand(scanCmd)
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// scheduleReloadInterval bounds how long schedule run sleeps before reading the schedules again,
+// so schedules added or removed meanwhile are picked up
+const scheduleReloadInterval = time.Minute
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Run project operations on a schedule",
+	Long: `Schedules run an operation on a project at the times of a cron expression, e.g. a nightly update.
+Operations are start, stop, restart, pull and update, which pulls new images and recreates the containers of a
+running project whose images changed. Schedules are stored in the dockyard config directory and run by
+'dockyard schedule run', which keeps running in the foreground. Runs are recorded in the operation history.`,
+}
+
+var scheduleAddCmd = &cobra.Command{
+	Use:   "add [project] [operation] [cron-expr]",
+	Short: "Add a recurring operation on a project",
+	Long: `Add a recurring operation on a project. The cron expression has the five standard fields, minute hour
+day-of-month month day-of-week, e.g. "0 3 * * *" for every night at 3:00, or is a descriptor such as @daily
+or @every 6h. It is read in the local time zone.`,
+	Args: cobra.MinimumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+
+		// Accept an unquoted expression split over several arguments
+		expression := strings.Join(args[2:], " ")
+		schedule, err := docker.AddSchedule(projectName, args[1], expression)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		parsed, _ := docker.ParseCron(schedule.Cron)
+		fmt.Printf("✅ Scheduled %s of %s at '%s' (id %s)\n", schedule.Operation, projectName, schedule.Cron, schedule.ID)
+		fmt.Printf("⏰ Next run: %s\n", parsed.Next(time.Now()).Format("2006-01-02 15:04"))
+		fmt.Println("💡 Run 'dockyard schedule run' to execute the schedules")
+	},
+}
+
+var scheduleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the schedules",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		schedules, err := docker.LoadSchedules()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(schedules) == 0 {
+			fmt.Println("📭 No schedules defined")
+			fmt.Println("💡 Tip: Run 'dockyard schedule add [project] [operation] [cron-expr]' to add one")
+			return
+		}
+
+		fmt.Printf("   %-10s %-20s %-10s %-20s %s\n", "ID", "PROJECT", "OPERATION", "CRON", "NEXT RUN")
+		for _, schedule := range schedules {
+			next := "invalid expression"
+			if parsed, err := docker.ParseCron(schedule.Cron); err == nil {
+				next = parsed.Next(time.Now()).Format("2006-01-02 15:04")
+			}
+			fmt.Printf("⏰ %-10s %-20s %-10s %-20s %s\n", schedule.ID, schedule.Project, schedule.Operation, schedule.Cron, next)
+		}
+	},
+}
+
+var scheduleRemoveCmd = &cobra.Command{
+	Use:   "remove [id]",
+	Short: "Remove a schedule",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		schedule, err := docker.RemoveSchedule(args[0])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Printf("✅ Removed the %s schedule of %s ('%s')\n", schedule.Operation, schedule.Project, schedule.Cron)
+	},
+}
+
+var scheduleRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run the schedules until interrupted",
+	Long: `Keep running and execute each schedule at its times, one operation at a time. Schedules added or removed
+while running are picked up within a minute. Runs missed while this command was not running are not caught up.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		docker.Unattended = true
+		runSchedules()
+	},
+}
+
+func runSchedules() {
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupts)
+
+	fmt.Println("⏰ Running schedules, press Ctrl+C to stop")
+
+	var schedules []docker.Schedule
+	last := time.Now()
+	for {
+		if loaded, err := docker.LoadSchedules(); err != nil {
+			fmt.Printf("⚠️  Failed to reload schedules, keeping the previous ones: %v\n", err)
+		} else {
+			schedules = loaded
+		}
+		// Pick up projects registered, moved or removed since the runner started
+		if err := docker.ReloadProjectsFromFile("projects.json"); err != nil {
+			fmt.Printf("⚠️  Failed to reload projects.json, keeping the previous projects: %v\n", err)
+		}
+
+		wait := scheduleReloadInterval
+		for _, schedule := range schedules {
+			parsed, err := docker.ParseCron(schedule.Cron)
+			if err != nil {
+				continue
+			}
+			if until := time.Until(parsed.Next(last)); until < wait {
+				wait = max(until, 0)
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-interrupts:
+			timer.Stop()
+			fmt.Println("\n✅ Stopped running schedules")
+			return
+		case <-timer.C:
+		}
+
+		now := time.Now()
+		for _, schedule := range schedules {
+			parsed, err := docker.ParseCron(schedule.Cron)
+			if err != nil || parsed.Next(last).After(now) {
+				continue
+			}
+			runSchedule(schedule)
+		}
+		last = now
+	}
+}
+
+// runSchedule executes one scheduled operation and records it in the history
+func runSchedule(schedule docker.Schedule) {
+	fmt.Printf("\n[%s] ▶ %s of %s (schedule %s)\n", time.Now().Format("2006-01-02 15:04:05"), schedule.Operation, schedule.Project, schedule.ID)
+
+	projectPath, ok := docker.Projects[schedule.Project]
+	if !ok {
+		fmt.Printf("⚠️  Project %s is no longer registered, skipping\n", schedule.Project)
+		return
+	}
+
+	projectDir, err := utils.ResolveHomeDir(projectPath)
+	if err != nil {
+		fmt.Printf("❌ Failed to resolve home directory in %s: %v\n", projectPath, err)
+		reportOperation(schedule.Project, "scheduled-"+schedule.Operation, err)
+		return
+	}
+
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Printf("❌ Failed to create compose manager: %v\n", err)
+		reportOperation(schedule.Project, "scheduled-"+schedule.Operation, err)
+		return
+	}
+	defer cm.Close()
+
+	applyProjectTimeout(cm, schedule.Project)
+	err = cm.RunScheduledOperation(schedule.Project, projectDir, schedule.Operation)
+	reportOperation(schedule.Project, "scheduled-"+schedule.Operation, err)
+	if err != nil {
+		fmt.Printf("❌ Scheduled %s of %s failed: %v\n", schedule.Operation, schedule.Project, err)
+		return
+	}
+	fmt.Printf("✅ Scheduled %s of %s done\n", schedule.Operation, schedule.Project)
+}
+
+func init() {
+	scheduleCmd.AddCommand(scheduleAddCmd)
+	scheduleCmd.AddCommand(scheduleListCmd)
+	scheduleCmd.AddCommand(scheduleRemoveCmd)
+	scheduleCmd.AddCommand(scheduleRunCmd)
+	rootCmd.AddCommand(scheduleCmd)
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var servicesCmd = &cobra.Command{
+	Use:   "services [project]",
+	Short: "List services with their configured and running images",
+	Long: `Show each service of a project with the image declared in the compose file next to the image its
+container runs. Services whose container was created from an older image than the one the
+configured tag now points to are marked as outdated. Build-based services show the built image.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		images, err := cm.GetServiceImages(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get images for project %s: %v\n", projectName, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		fmt.Printf("🧩 Services of project '%s':\n", projectName)
+		fmt.Printf("   %-20s %-35s %-13s %-35s %s\n", "SERVICE", "CONFIGURED", "ID", "RUNNING", "ID")
+		fmt.Println(strings.Repeat("-", 120))
+
+		outdated := 0
+		for _, image := range images {
+			emoji := "🟢"
+			switch image.Status {
+			case docker.ImageOutdated:
+				emoji = "🟠"
+				outdated++
+			case docker.ImageNoContainer:
+				emoji = "⚪"
+			}
+
+			configured := image.Configured
+			if image.Built {
+				configured += " (build)"
+			}
+			running := image.Running
+			if running == "" {
+				running = "-"
+			}
+
+			fmt.Printf("%s %-20s %-35s %-13s %-35s %s\n", emoji, image.Service, configured,
+				shortIDOrDash(image.ConfiguredID), running, shortIDOrDash(image.RunningID))
+		}
+		fmt.Println()
+
+		if outdated > 0 {
+			fmt.Println(ui.RenderWarning(fmt.Sprintf("%d service(s) run an older image than configured", outdated)))
+			fmt.Printf("💡 Tip: Run 'dockyard start %s' to recreate them with the current image\n", projectName)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(servicesCmd)
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var sessionCmd = &cobra.Command{
+	Use:   "session",
+	Short: "Save and restore sets of running projects",
+	Long: `Sessions record which projects are running so the same set of stacks can be brought back later, e.g. a "demo" environment.
+Sessions are stored in the dockyard config directory. Without a name, the "default" session is used.`,
+}
+
+var sessionSaveCmd = &cobra.Command{
+	Use:   "save [name]",
+	Short: "Record the currently running projects as a session",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := sessionName(args)
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		running := runningProjects(cm)
+		if len(running) == 0 {
+			fmt.Println("💤 No project is running, nothing to save")
+			return
+		}
+
+		session, err := docker.SaveSession(name, running)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Saved session %s with %d project(s): %s",
+			session.Name, len(session.Projects), strings.Join(session.Projects, ", "))))
+	},
+}
+
+var sessionRestoreCmd = &cobra.Command{
+	Use:   "restore [name]",
+	Short: "Start the projects recorded in a session",
+	Long:  `Start every project recorded in a session, skipping the ones that are already running and the ones no longer registered.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		session, err := docker.LoadSession(sessionName(args))
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		running := make(map[string]bool)
+		for _, projectName := range runningProjects(cm) {
+			running[projectName] = true
+		}
+
+		var toStart []string
+		for _, projectName := range session.Projects {
+			switch {
+			case docker.Projects[projectName] == "":
+				fmt.Printf("⚠️  Skipping %s: no longer in projects.json\n", projectName)
+			case running[projectName]:
+				fmt.Printf("⏭️  Skipping %s: already running\n", projectName)
+			default:
+				toStart = append(toStart, projectName)
+			}
+		}
+
+		if len(toStart) == 0 {
+			fmt.Printf("✅ Every project of session %s is already running\n", session.Name)
+			return
+		}
+		fmt.Println()
+
+		runner := &projectRunner{}
+		runner.startProjects(toStart)
+		runner.handleResults(toStart)
+		if len(runner.failedProjects) > 0 {
+			setExitCode(ExitFailure)
+		}
+	},
+}
+
+var sessionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved sessions",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		sessions, err := docker.ListSessions()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if len(sessions) == 0 {
+			fmt.Println("📭 No saved sessions")
+			fmt.Println("💡 Tip: Run 'dockyard session save [name]' to record the running projects")
+			return
+		}
+
+		for _, session := range sessions {
+			fmt.Printf("💾 %-20s %s  %s\n", session.Name, session.SavedAt.Format("2006-01-02 15:04"), strings.Join(session.Projects, ", "))
+		}
+	},
+}
+
+// sessionName returns the session named on the command line or the default session
+func sessionName(args []string) string {
+	if len(args) == 0 {
+		return docker.DefaultSessionName
+	}
+	return args[0]
+}
+
+// runningProjects returns the sorted names of projects with at least one running container
+func runningProjects(cm *docker.ComposeManager) []string {
+	projectNames := docker.GetSortedProjectNames()
+	results := fetchProjectStatuses(cm, projectNames, false)
+
+	var running []string
+	for i, projectName := range projectNames {
+		if countRunningContainers(results[i].statuses) > 0 {
+			running = append(running, projectName)
+		}
+	}
+	return running
+}
+
+func init() {
+	sessionCmd.AddCommand(sessionSaveCmd)
+	sessionCmd.AddCommand(sessionRestoreCmd)
+	sessionCmd.AddCommand(sessionListCmd)
+	rootCmd.AddCommand(sessionCmd)
+}
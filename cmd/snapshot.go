@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotOutput string
+	snapshotRedact bool
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot [project]",
+	Short: "Export a diagnostic snapshot of a project as JSON",
+	Long: `Write a single JSON report with the resolved compose config, container statuses, resource usage,
+service health and the last log lines of a project, ready to attach to a bug report.
+Secret values are masked unless --redact=false is given.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		fmt.Printf("📸 Taking snapshot of project '%s'...\n", projectName)
+		snapshot, err := cm.TakeSnapshot(projectName, projectDir, snapshotRedact)
+		if err != nil {
+			fmt.Printf("❌ Failed to take snapshot of %s: %v\n", projectName, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		data, err := json.MarshalIndent(snapshot, "", "  ")
+		if err != nil {
+			fmt.Printf("❌ Failed to encode snapshot: %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		output := snapshotOutput
+		if output == "" {
+			output = fmt.Sprintf("%s-snapshot-%s.json", projectName, snapshot.CreatedAt.Format("20060102-150405"))
+		}
+		if err := os.WriteFile(output, data, 0600); err != nil {
+			fmt.Printf("❌ Failed to write %s: %v\n", output, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		for _, snapshotErr := range snapshot.Errors {
+			fmt.Printf("⚠️  Incomplete %s\n", snapshotErr)
+		}
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Snapshot written to %s", output)))
+		if !snapshotRedact {
+			fmt.Println("⚠️  Secrets were not masked, review the file before sharing it")
+		}
+	},
+}
+
+func init() {
+	snapshotCmd.Flags().StringVarP(&snapshotOutput, "output", "o", "", "File to write the snapshot to (default <project>-snapshot-<time>.json)")
+	snapshotCmd.Flags().BoolVar(&snapshotRedact, "redact", true, "Mask the values of secret environment keys")
+	rootCmd.AddCommand(snapshotCmd)
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var spawnRemove bool
+
+var spawnCmd = &cobra.Command{
+	Use:   "spawn [project] [new-project-name]",
+	Short: "Start a second copy of a project under another name",
+	Long: `Start an isolated copy of a project under another compose project name, e.g. to compare two versions side by side.
+Published host ports are moved to the next free ports and reported, container names are dropped and the copy gets
+its own volumes and networks. The copy is not registered as a project, use --rm to take it down and delete its volumes.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+		spawnName := args[1]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		if spawnRemove {
+			err := cm.RemoveSpawn(projectDir, spawnName)
+			reportOperation(projectName, "spawn-remove", err)
+			if err != nil {
+				fmt.Printf("Failed to remove spawned stack %s: %v\n", spawnName, err)
+				setExitCodeForError(err)
+				return
+			}
+			fmt.Printf("✅ Spawned stack %s removed\n", spawnName)
+			return
+		}
+
+		spawn, err := cm.SpawnProject(projectDir, spawnName)
+		reportOperation(projectName, "spawn", err)
+		if err != nil {
+			fmt.Printf("Failed to spawn project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("✅ %s is running as %s\n", projectName, spawn.Name)
+		if len(spawn.Ports) > 0 {
+			fmt.Println("🔀 Remapped ports:")
+			for _, port := range spawn.Ports {
+				fmt.Printf("   %-20s %d/%s  %d → %d\n", port.Service, port.Target, port.Protocol, port.From, port.To)
+			}
+		}
+		fmt.Printf("📄 Compose file: %s\n", spawn.ComposeFile)
+		fmt.Printf("💡 Run 'dockyard spawn %s %s --rm' to remove it\n", projectName, spawn.Name)
+	},
+}
+
+func init() {
+	spawnCmd.Flags().BoolVar(&spawnRemove, "rm", false, "Take the spawned copy down, delete its volumes and its compose file")
+	rootCmd.AddCommand(spawnCmd)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var staleCmd = &cobra.Command{
+	Use:   "stale [project]",
+	Short: "Detect containers running an outdated compose configuration",
+	Long:  `Compare the config hash of each service's container with the hash compose computes for the current configuration, and offer to recreate the services whose configuration changed.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		results, err := cm.FindStaleServices(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to check project %s: %v\n", projectName, err)
+			return
+		}
+
+		fmt.Printf("🧾 Configuration freshness for project '%s':\n", projectName)
+		fmt.Printf("   %-25s %s\n", "SERVICE", "STATE")
+		fmt.Println(strings.Repeat("-", 45))
+
+		var stale []string
+		for _, result := range results {
+			switch {
+			case !result.HasContainer():
+				fmt.Printf("⚪ %-25s no container\n", result.Service)
+			case result.IsStale():
+				fmt.Printf("🟠 %-25s stale\n", result.Service)
+				stale = append(stale, result.Service)
+			default:
+				fmt.Printf("🟢 %-25s fresh\n", result.Service)
+			}
+		}
+		fmt.Println()
+
+		if len(stale) == 0 {
+			fmt.Println(ui.RenderSuccess("All containers match the current configuration"))
+			return
+		}
+
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("%d service(s) changed since their container was created", len(stale))))
+
+		var recreate bool
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Recreate %s?", strings.Join(stale, ", ")),
+			Default: false,
+		}
+		if err := survey.AskOne(prompt, &recreate); err != nil || !recreate {
+			return
+		}
+
+		if err := cm.RecreateServices(projectDir, stale); err != nil {
+			fmt.Printf("❌ Failed to recreate services: %v\n", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(staleCmd)
+}
@@ -4,6 +4,9 @@ import (
 	"dockyard/pkg/docker"
 	"dockyard/pkg/utils"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -11,30 +14,52 @@ import (
 var (
 	removeOrphans bool
 	detached      bool
+	waitReady     bool
+	waitTimeout   time.Duration
+	startNoDeps   bool
+	startStatus   bool
+	startOffline  bool
+	startForce    bool
 )
 
+// startStatusDelay gives containers a moment to settle before --status shows them
+const startStatusDelay = 2 * time.Second
+
 var startCmd = &cobra.Command{
-	Use:   "start [project]",
+	Use:   "start [project] [service...]",
 	Short: "Start a Docker project",
-	Long:  `Start all Docker containers of a project using Docker Compose`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Start all Docker containers of a project using Docker Compose, or only the given services.
+Use --no-deps with specific services to start them without their dependencies.
+Use --status to show the project's containers right after a detached start.
+Use --offline to refuse starting when an image is missing locally instead of pulling it.
+When every service is already up and matches the compose files, saved scale and pending limits,
+nothing is done unless --force is given.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		services := args[1:]
+		if startNoDeps && len(services) == 0 {
+			fmt.Println("The --no-deps flag requires at least one service")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
 			return
 		}
 
 		cm, err := docker.NewComposeManager()
 		if err != nil {
 			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
 			return
 		}
 		defer func(cm *docker.ComposeManager) {
@@ -46,18 +71,247 @@ var startCmd = &cobra.Command{
 			}
 		}(cm)
 
-		err = cm.StartProject(projectDir, detached, removeOrphans)
+		if !startForce && projectAlreadyRunning(cm, projectName, projectDir, services) {
+			fmt.Printf("ℹ️  Project %s is already running and up to date, nothing to start\n", projectName)
+			fmt.Printf("💡 Use 'dockyard restart %s' to restart it, or 'dockyard start %s --force' to start it anyway\n", projectName, projectName)
+			return
+		}
+
+		applyProjectTimeout(cm, projectName)
+
+		err = cm.StartProject(projectDir, docker.StartOptions{
+			Detached:      detached,
+			RemoveOrphans: removeOrphans,
+			Scale:         docker.ProjectsSettings[projectName].Scale,
+			Services:      services,
+			NoDeps:        startNoDeps,
+			Offline:       startOffline,
+		})
+		reportOperation(projectName, "start", err)
 		if err != nil {
 			fmt.Printf("Failed to start project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
 			return
 		}
 
 		fmt.Printf("✅ Project %s started successfully!\n", projectName)
+		applyPendingLimits(cm, projectName, projectDir)
+
+		if waitReady {
+			if !waitForServices(cm, projectName, projectDir, services) {
+				return
+			}
+			waitForReadiness(cm, projectName)
+		}
+
+		if startStatus && detached {
+			time.Sleep(startStatusDelay)
+			showStatusAfterStart(cm, projectName, projectDir)
+		}
 	},
 }
 
+// projectAlreadyRunning reports whether every service of the project, or every given service, is
+// already up and matches what a start would apply: the current compose configuration, the saved
+// scale and no pending limits. Errors are left to the start itself to report.
+func projectAlreadyRunning(cm *docker.ComposeManager, projectName, projectDir string, services []string) bool {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return false
+	}
+
+	states, err := cm.GetServiceReadiness(project)
+	if err != nil {
+		return false
+	}
+	if len(services) == 0 {
+		services = project.ServiceNames()
+	}
+
+	for _, service := range services {
+		state, ok := states[service]
+		if !ok || !docker.IsReadyState(state) {
+			return false
+		}
+	}
+
+	settings := docker.ProjectsSettings[projectName]
+	for _, service := range services {
+		if _, pending := settings.PendingLimits[service]; pending {
+			return false
+		}
+	}
+
+	freshness, err := cm.FindStaleServices(projectDir)
+	if err != nil {
+		return false
+	}
+	for _, sf := range freshness {
+		if sf.IsStale() && slices.Contains(services, sf.Service) {
+			return false
+		}
+	}
+
+	if len(settings.Scale) > 0 {
+		containers, err := cm.GetProjectContainers(project.Name)
+		if err != nil {
+			return false
+		}
+		replicas := make(map[string]int)
+		for _, cont := range containers {
+			replicas[cont.Labels["com.docker.compose.service"]]++
+		}
+		for _, service := range services {
+			if scale, ok := settings.Scale[service]; ok && replicas[service] != scale {
+				return false
+			}
+		}
+	}
+
+	return len(services) > 0
+}
+
+// showStatusAfterStart prints the containers of a project that was just started. It reuses the
+// caller's compose manager so Docker is not checked again.
+func showStatusAfterStart(cm *docker.ComposeManager, projectName, projectDir string) {
+	statuses, err := cm.GetProjectStatus(projectDir)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to get status for project %s: %v\n", projectName, err)
+		return
+	}
+	if len(statuses) == 0 {
+		fmt.Printf("📭 No containers found for project '%s'\n", projectName)
+		return
+	}
+
+	fmt.Printf("\n📊 Status for project '%s':\n", projectName)
+	printStatusTable(statuses, nil)
+}
+
+// waitForServices shows a live tree of the project's services, ordered by their depends_on
+// graph, until every service is running (or healthy when it defines a healthcheck). When
+// services are given, only those are waited for.
+func waitForServices(cm *docker.ComposeManager, projectName, projectDir string, services []string) bool {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		fmt.Printf("❌ Failed to load project %s: %v\n", projectName, err)
+		setExitCodeForError(err)
+		return false
+	}
+
+	nodes := docker.DependencyGraph(project)
+	if len(services) > 0 {
+		nodes = slices.DeleteFunc(nodes, func(node docker.ServiceNode) bool {
+			return !slices.Contains(services, node.Service)
+		})
+	}
+	deadline := time.Now().Add(waitTimeout)
+
+	fmt.Printf("⏳ Waiting for %d service(s) of %s (timeout %s)...\n", len(nodes), projectName, waitTimeout)
+	for drawn := false; ; drawn = true {
+		states, err := cm.GetServiceReadiness(project)
+		if err != nil {
+			fmt.Printf("❌ Failed to get service states: %v\n", err)
+			setExitCodeForError(err)
+			return false
+		}
+
+		if drawn {
+			// Move back over the previous tree to redraw it in place
+			fmt.Printf("\033[%dA\r\033[J", len(nodes))
+		}
+		ready, failed := renderServiceTree(nodes, states)
+
+		switch {
+		case len(failed) > 0:
+			fmt.Printf("❌ Service(s) failed to start: %s\n", strings.Join(failed, ", "))
+			setExitCode(ExitFailure)
+			return false
+		case ready == len(nodes):
+			fmt.Printf("✅ All services of %s are up\n", projectName)
+			return true
+		case time.Now().After(deadline):
+			fmt.Printf("❌ Timed out after %s with %d/%d service(s) up\n", waitTimeout, ready, len(nodes))
+			setExitCode(ExitFailure)
+			return false
+		}
+
+		time.Sleep(docker.ProbeInterval)
+	}
+}
+
+// renderServiceTree prints one line per service, indented by dependency depth, and returns
+// the number of ready services and the names of failed ones
+func renderServiceTree(nodes []docker.ServiceNode, states map[string]string) (int, []string) {
+	ready := 0
+	var failed []string
+
+	for _, node := range nodes {
+		state := states[node.Service]
+
+		icon := "⏳"
+		switch {
+		case docker.IsReadyState(state):
+			icon = "🟢"
+			ready++
+		case docker.IsFailedState(state):
+			icon = "🔴"
+			failed = append(failed, node.Service)
+		}
+
+		prefix := ""
+		if node.Level > 0 {
+			prefix = strings.Repeat("   ", node.Level-1) + "└─ "
+		}
+
+		line := fmt.Sprintf("   %s%s %s (%s)", prefix, icon, node.Service, state)
+		if len(node.DependsOn) > 0 && !docker.IsReadyState(state) {
+			var pending []string
+			for _, dep := range node.DependsOn {
+				if !docker.IsReadyState(states[dep]) {
+					pending = append(pending, dep)
+				}
+			}
+			if len(pending) > 0 {
+				line += fmt.Sprintf(" ← waiting on %s", strings.Join(pending, ", "))
+			}
+		}
+		fmt.Println(line)
+	}
+
+	return ready, failed
+}
+
+// waitForReadiness runs the readiness probes configured for the project and reports failures
+func waitForReadiness(cm *docker.ComposeManager, projectName string) {
+	probes := docker.ProjectsSettings[projectName].Probes
+	if len(probes) == 0 {
+		return
+	}
+
+	results, err := cm.RunReadinessProbes(probes, waitTimeout)
+	if err == nil {
+		fmt.Printf("✅ Project %s is ready!\n", projectName)
+		return
+	}
+
+	fmt.Printf("❌ %v\n", err)
+	setExitCode(ExitFailure)
+	for _, result := range results {
+		if !result.Ready {
+			fmt.Printf("   ❌ %s (%s): %s\n", result.Service, result.Target, result.LastError)
+		}
+	}
+}
+
 func init() {
 	startCmd.Flags().BoolVar(&removeOrphans, "remove-orphans", true, "Remove containers for services not defined in the Compose file")
 	startCmd.Flags().BoolVarP(&detached, "detach", "d", true, "Detached mode: Run containers in the background")
+	startCmd.Flags().BoolVar(&waitReady, "wait", false, "Wait for all services to be up (healthy when they define a healthcheck) and for the readiness probes configured in projects.json to respond")
+	startCmd.Flags().BoolVar(&startNoDeps, "no-deps", false, "Don't start the dependencies of the given services")
+	startCmd.Flags().BoolVar(&startStatus, "status", false, "Show the status of the project's containers after a detached start")
+	startCmd.Flags().BoolVar(&startOffline, "offline", false, "Refuse to start if an image is missing locally and never pull")
+	startCmd.Flags().BoolVar(&startForce, "force", false, "Start even when every service is already running")
+	startCmd.Flags().DurationVar(&waitTimeout, "wait-timeout", 2*time.Minute, "Maximum time to wait for services and readiness probes")
 	rootCmd.AddCommand(startCmd)
 }
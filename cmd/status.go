@@ -2,35 +2,57 @@ package cmd
 
 import (
 	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
 	"dockyard/pkg/utils"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
+var (
+	showUptime    bool
+	statusOneline bool
+)
+
+// onelineStatusTimeout bounds how long status --oneline may take, so shell prompts never block
+const onelineStatusTimeout = 2 * time.Second
+
 var statusCmd = &cobra.Command{
 	Use:   "status [project]",
 	Short: "Show status of Docker project containers",
-	Long:  `Display detailed status information for all containers in a project`,
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Display detailed status information for all containers in a project.
+Use --oneline for a compact summary of all projects such as "▲3 ●1 ▼2" (running, partially running and stopped), meant for shell prompts and tmux status bars.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		if statusOneline {
+			if len(args) > 0 {
+				fmt.Println("❌ --oneline summarizes all projects and takes no project argument")
+				setExitCode(ExitFailure)
+				return
+			}
+			showOnelineStatus()
+			return
+		}
+
 		if len(args) == 0 {
 			// Show status for all projects
 			showAllProjectsStatus()
 			return
 		}
 
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
 			return
 		}
 
@@ -44,12 +66,14 @@ func showProjectStatus(projectName, projectDir string) {
 	if err != nil {
 		fmt.Printf("❌ Docker status check failed: %v\n", err)
 		fmt.Printf("📁 Project '%s' location: %s\n", projectName, projectDir)
+		setExitCodeForError(err)
 		return
 	}
 
 	cm, err := docker.NewComposeManager()
 	if err != nil {
 		fmt.Printf("Failed to create compose manager: %v\n", err)
+		setExitCodeForError(err)
 		return
 	}
 	defer func(cm *docker.ComposeManager) {
@@ -64,6 +88,7 @@ func showProjectStatus(projectName, projectDir string) {
 	statuses, err := cm.GetProjectStatus(projectDir)
 	if err != nil {
 		fmt.Printf("Failed to get status for project %s: %v\n", projectName, err)
+		setExitCodeForError(err)
 		return
 	}
 
@@ -73,13 +98,56 @@ func showProjectStatus(projectName, projectDir string) {
 		return
 	}
 
+	var uptimes map[string]docker.ContainerUptime
+	if showUptime {
+		uptimes, err = projectUptimesByName(cm, projectDir)
+		if err != nil {
+			fmt.Printf("⚠️  Failed to get uptime for project %s: %v\n", projectName, err)
+			uptimes = map[string]docker.ContainerUptime{}
+		}
+	}
+
 	fmt.Printf("📊 Status for project '%s':\n", projectName)
-	fmt.Printf("%-25s %-12s %-10s %-20s %s\n", "SERVICE", "ID", "STATE", "STATUS", "PORTS")
-	fmt.Println(strings.Repeat("-", 85))
+	if note := docker.ProjectsSettings[projectName].Note; note != "" {
+		fmt.Printf("📝 %s\n", note)
+	}
+	printDockerContext()
+	printStatusTable(statuses, uptimes)
+}
+
+// printStatusTable prints one line per container. Uptime columns are shown when uptimes is not nil.
+func printStatusTable(statuses []docker.ContainerStatus, uptimes map[string]docker.ContainerUptime) {
+	withUptime := uptimes != nil
+	if withUptime {
+		fmt.Printf("%-25s %-12s %-10s %-20s %-10s %-9s %s\n", "SERVICE", "ID", "STATE", "STATUS", "UPTIME", "RESTARTS", "PORTS")
+		fmt.Println(strings.Repeat("-", 105))
+	} else {
+		fmt.Printf("%-25s %-12s %-10s %-20s %s\n", "SERVICE", "ID", "STATE", "STATUS", "PORTS")
+		fmt.Println(strings.Repeat("-", 85))
+	}
 
 	for _, status := range statuses {
 		stateEmoji := getStateEmoji(status.State)
 
+		if withUptime {
+			uptime, restarts := "-", "-"
+			if u, ok := uptimes[status.Name]; ok {
+				uptime = formatUptime(u)
+				restarts = fmt.Sprintf("%d", u.RestartCount)
+			}
+
+			fmt.Printf("%-25s %-12s %s%-9s %-20s %-10s %-9s %s\n",
+				status.Service,
+				status.ID,
+				stateEmoji,
+				status.State,
+				status.Status,
+				uptime,
+				restarts,
+				status.Ports)
+			continue
+		}
+
 		fmt.Printf("%-25s %-12s %s%-9s %-20s %s\n",
 			status.Service,
 			status.ID,
@@ -90,8 +158,23 @@ func showProjectStatus(projectName, projectDir string) {
 	}
 }
 
+// projectUptimesByName returns the uptime of each project container keyed by container name
+func projectUptimesByName(cm *docker.ComposeManager, projectDir string) (map[string]docker.ContainerUptime, error) {
+	uptimes, err := cm.GetProjectUptimes(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]docker.ContainerUptime, len(uptimes))
+	for _, uptime := range uptimes {
+		byName[uptime.Name] = uptime
+	}
+	return byName, nil
+}
+
 func showAllProjectsStatus() {
 	fmt.Println("📊 Status for all projects:")
+	printDockerContext()
 	fmt.Println()
 
 	// Check Docker status first
@@ -105,52 +188,164 @@ func showAllProjectsStatus() {
 		sortedProjectNames := docker.GetSortedProjectNames()
 		for _, projectName := range sortedProjectNames {
 			projectPath := docker.Projects[projectName]
-			fmt.Printf("📁 %s: %s\n", projectName, projectPath)
+			fmt.Printf("📁 %s: %s%s\n", projectName, projectPath, projectNoteSuffix(projectName))
 		}
+		setExitCodeForError(err)
 		return
 	}
 
-	sortedProjectNames := docker.GetSortedProjectNames()
-	for _, projectName := range sortedProjectNames {
-		projectPath := docker.Projects[projectName]
-		projectDir, err := utils.ResolveHomeDir(projectPath)
-		if err != nil {
-			fmt.Printf("❌ %s: Failed to resolve path: %v\n", projectName, err)
-			continue
-		}
-
-		cm, err := docker.NewComposeManager()
-		if err != nil {
-			fmt.Printf("❌ %s: Failed to create compose manager: %v\n", projectName, err)
-			continue
-		}
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Printf("Failed to create compose manager: %v\n", err)
+		setExitCodeForError(err)
+		return
+	}
+	defer cm.Close()
 
-		statuses, err := cm.GetProjectStatus(projectDir)
-		cm.Close()
+	sortedProjectNames := docker.GetSortedProjectNames()
+	results := fetchProjectStatuses(cm, sortedProjectNames, true)
 
-		if err != nil {
-			fmt.Printf("❌ %s: Failed to get status: %v\n", projectName, err)
+	for i, projectName := range sortedProjectNames {
+		result := results[i]
+		if result.failure != "" {
+			fmt.Printf("❌ %s: %s\n", projectName, result.failure)
+			setExitCode(ExitFailure)
 			continue
 		}
 
-		if len(statuses) == 0 {
-			fmt.Printf("📭 %s: No containers\n", projectName)
+		if len(result.statuses) == 0 {
+			fmt.Printf("📭 %s: No containers%s\n", projectName, projectNoteSuffix(projectName))
 		} else {
-			runningCount := 0
-			for _, status := range statuses {
-				if status.State == "running" {
-					runningCount++
-				}
-			}
+			runningCount := countRunningContainers(result.statuses)
 
 			statusEmoji := "⏹️"
 			if runningCount > 0 {
 				statusEmoji = "🟢"
 			}
 
-			fmt.Printf("%s %s: %d/%d containers running\n",
-				statusEmoji, projectName, runningCount, len(statuses))
+			fmt.Printf("%s %s: %d/%d containers running%s\n",
+				statusEmoji, projectName, runningCount, len(result.statuses), projectNoteSuffix(projectName))
+		}
+	}
+}
+
+// showOnelineStatus prints the number of running, partially running and stopped projects on a
+// single line. It never prompts and prints "?" when the daemon does not answer in time.
+func showOnelineStatus() {
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Println("?")
+		return
+	}
+	defer cm.Close()
+
+	sortedProjectNames := docker.GetSortedProjectNames()
+	fetched := make(chan []projectStatusResult, 1)
+	go func() {
+		fetched <- fetchProjectStatuses(cm, sortedProjectNames, false)
+	}()
+
+	var results []projectStatusResult
+	select {
+	case results = <-fetched:
+	case <-time.After(onelineStatusTimeout):
+		fmt.Println("?")
+		return
+	}
+
+	var running, partial, stopped int
+	for _, result := range results {
+		runningCount := countRunningContainers(result.statuses)
+		switch {
+		case runningCount == 0:
+			stopped++
+		case runningCount < len(result.statuses):
+			partial++
+		default:
+			running++
+		}
+	}
+
+	fmt.Printf("▲%d ●%d ▼%d\n", running, partial, stopped)
+}
+
+// statusWorkers bounds the number of projects queried concurrently
+const statusWorkers = 8
+
+// projectStatusResult holds the outcome of fetching a single project's status
+type projectStatusResult struct {
+	statuses []docker.ContainerStatus
+	failure  string
+	err      error
+}
+
+// fetchProjectStatuses queries the status of all projects concurrently using a shared
+// compose manager, optionally rendering a progress bar while fetching. Results keep the input order.
+func fetchProjectStatuses(cm *docker.ComposeManager, projectNames []string, showProgress bool) []projectStatusResult {
+	results := make([]projectStatusResult, len(projectNames))
+	jobs := make(chan int)
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for w := 0; w < statusWorkers && w < len(projectNames); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = fetchProjectStatus(cm, docker.Projects[projectNames[i]])
+				done <- struct{}{}
+			}
+		}()
+	}
+
+	go func() {
+		for i := range projectNames {
+			jobs <- i
 		}
+		close(jobs)
+		wg.Wait()
+		close(done)
+	}()
+
+	if !showProgress {
+		// Drain completions until every worker is done
+		for range done {
+		}
+		return results
+	}
+
+	completed := 0
+	fmt.Println(ui.RenderProgress("Fetching project status", completed, len(projectNames)))
+	for range done {
+		completed++
+		// Move back over the previous progress output and redraw it
+		fmt.Print("\033[2A\r\033[J")
+		fmt.Println(ui.RenderProgress("Fetching project status", completed, len(projectNames)))
+	}
+	fmt.Print("\033[2A\r\033[J")
+
+	return results
+}
+
+// fetchProjectStatus returns the container statuses of the project at projectPath
+func fetchProjectStatus(cm *docker.ComposeManager, projectPath string) projectStatusResult {
+	projectDir, err := utils.ResolveHomeDir(projectPath)
+	if err != nil {
+		return projectStatusResult{failure: fmt.Sprintf("Failed to resolve path: %v", err), err: err}
+	}
+
+	statuses, err := cm.GetProjectStatus(projectDir)
+	if err != nil {
+		return projectStatusResult{failure: fmt.Sprintf("Failed to get status: %v", err), err: err}
+	}
+
+	return projectStatusResult{statuses: statuses}
+}
+
+// printDockerContext shows which docker context the status was collected from
+func printDockerContext() {
+	if dockerContext := docker.CurrentContext(); dockerContext != "" {
+		fmt.Printf("🐳 Docker context: %s\n", dockerContext)
 	}
 }
 
@@ -170,5 +365,7 @@ func getStateEmoji(state string) string {
 }
 
 func init() {
+	statusCmd.Flags().BoolVar(&statusOneline, "oneline", false, "Print a compact summary of all projects for shell prompts")
+	statusCmd.Flags().BoolVar(&showUptime, "uptime", false, "Show uptime and restart count of each container")
 	rootCmd.AddCommand(statusCmd)
 }
@@ -19,22 +19,23 @@ var stopCmd = &cobra.Command{
 	Long:  `Stop a Docker project by its name`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
-		projectPath, ok := docker.Projects[projectName]
+		projectName, ok := resolveProjectName(args[0])
 		if !ok {
-			fmt.Printf("Unknown project: %s\n", projectName)
 			return
 		}
+		projectPath := docker.Projects[projectName]
 
 		projectDir, err := utils.ResolveHomeDir(projectPath)
 		if err != nil {
 			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
 			return
 		}
 
 		cm, err := docker.NewComposeManager()
 		if err != nil {
 			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
 			return
 		}
 		defer func(cm *docker.ComposeManager) {
@@ -46,9 +47,13 @@ var stopCmd = &cobra.Command{
 			}
 		}(cm)
 
+		applyProjectTimeout(cm, projectName)
+
 		err = cm.StopProject(projectDir, removeVolumes, removeImages)
+		reportOperation(projectName, "stop", err)
 		if err != nil {
 			fmt.Printf("Failed to stop project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
 			return
 		}
 	},
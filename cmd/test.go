@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var testExitCodeFrom string
+
+var testCmd = &cobra.Command{
+	Use:   "test [project]",
+	Short: "Run a project as a test suite and exit with a service's exit code",
+	Long: `Run the project in the foreground with docker compose up --abort-on-container-exit, streaming its output. The run ends as soon as any container exits, and dockyard exits with the exit code of the --exit-code-from service, which is asked for when not given.
+The stack is always taken down afterwards, also when the run fails or is interrupted, so this suits compose-based integration tests in CI.`,
+	Example: `  dockyard test myapp --exit-code-from tests`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		service := testExitCodeFrom
+		if service == "" {
+			project, err := cm.LoadProject(projectDir)
+			if err != nil {
+				fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+				setExitCodeForError(err)
+				return
+			}
+
+			prompt := &survey.Select{
+				Message: "Which service's exit code is the test result?",
+				Options: project.ServiceNames(),
+			}
+			if err := survey.AskOne(prompt, &service); err != nil {
+				fmt.Println("❌ No service selected, use --exit-code-from")
+				setExitCode(ExitFailure)
+				return
+			}
+		}
+
+		applyProjectTimeout(cm, projectName)
+
+		fmt.Printf("🧪 Running project %s, the result is the exit code of %s\n", projectName, service)
+		exitCode, err := cm.RunTests(projectDir, service)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if exitCode != 0 {
+			fmt.Printf("❌ %s exited with code %d\n", service, exitCode)
+			setExitCode(exitCode)
+			return
+		}
+		fmt.Printf("✅ %s passed\n", service)
+	},
+}
+
+func init() {
+	testCmd.Flags().StringVar(&testExitCodeFrom, "exit-code-from", "", "Service whose exit code becomes dockyard's exit code")
+	rootCmd.AddCommand(testCmd)
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var timelineSince string
+
+var timelineCmd = &cobra.Command{
+	Use:   "timeline [project]",
+	Short: "Show what happened to a project recently",
+	Long: `Merge the operations dockyard ran on a project (▶) with the events docker reported for its containers (●) into one chronological view, so your commands and the daemon's reactions read as a single story.
+--since takes a duration back from now such as 2h, or a date such as 2024-05-01. The daemon only keeps a limited number of recent events.`,
+	Example: `  dockyard timeline myapp
+  dockyard timeline myapp --since 30m`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		since, err := docker.ParseSince(timelineSince)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		timeline, err := cm.ProjectTimeline(projectName, projectDir, since)
+		if err != nil {
+			fmt.Printf("❌ Failed to build the timeline of project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if len(timeline) == 0 {
+			fmt.Printf("📭 Nothing happened to project '%s' since %s\n", projectName, since.Format("2006-01-02 15:04"))
+			return
+		}
+
+		displayTimeline(projectName, timeline)
+	},
+}
+
+// displayTimeline prints one line per entry, marking dockyard operations and docker events
+func displayTimeline(projectName string, timeline []docker.TimelineEntry) {
+	fmt.Printf("🕒 Timeline of project '%s':\n\n", projectName)
+
+	for _, entry := range timeline {
+		line := entry.Action
+		if entry.Subject != "" {
+			line = entry.Subject + " " + line
+		}
+
+		marker := "●"
+		if entry.Source == docker.TimelineDockyard {
+			marker = "▶"
+			line = "dockyard " + line
+		}
+		if entry.Error != "" {
+			line += " " + ui.RenderWarning(entry.Error)
+		}
+
+		fmt.Printf("%s %s %s\n", entry.Time.Format("2006-01-02 15:04:05"), marker, line)
+	}
+}
+
+func init() {
+	timelineCmd.Flags().StringVar(&timelineSince, "since", docker.DefaultTimelineSince, "Show activity since a duration ago or a date")
+	rootCmd.AddCommand(timelineCmd)
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var tunablesCmd = &cobra.Command{
+	Use:   "tunables [project]",
+	Short: "Show ulimits and sysctls configured per service",
+	Long: `List the ulimits and sysctls of each service in a project, comparing what the compose file sets with what the running container actually has.
+Limits the compose file leaves out are shown with the daemon/host default the container inherited, and sysctls with the value of this host for comparison. Data stores such as databases that rely on defaults likely too low for them, like the open files limit, are flagged.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		services, err := cm.ProjectTunables(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get tunables for project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("🎛️  Tunables for project '%s':\n", projectName)
+		flagged := 0
+		for _, service := range services {
+			fmt.Printf("\n🔧 %s\n", service.Service)
+			if service.Container == "" {
+				fmt.Println("  (not running, effective values unknown)")
+			}
+
+			fmt.Printf("  %-12s %-10s %-24s %s\n", "ULIMIT", "SOURCE", "CONFIGURED", "EFFECTIVE")
+			for _, t := range service.Ulimits {
+				fmt.Printf("  %-12s %-10s %-24s %s\n", t.Name, t.Source, valueOrDash(t.Configured), valueOrUnknown(t.Effective))
+			}
+
+			if len(service.Sysctls) == 0 {
+				fmt.Println("  sysctls: host defaults")
+			} else {
+				fmt.Printf("  %-34s %-16s %-16s %s\n", "SYSCTL", "CONFIGURED", "EFFECTIVE", "HOST")
+				for _, t := range service.Sysctls {
+					fmt.Printf("  %-34s %-16s %-16s %s\n", t.Name, t.Configured, valueOrUnknown(t.Effective), valueOrUnknown(t.Host))
+				}
+			}
+
+			for _, warning := range service.Warnings {
+				fmt.Printf("  %s\n", ui.RenderWarning("⚠️  "+warning))
+			}
+			if len(service.Warnings) > 0 {
+				flagged++
+			}
+		}
+		fmt.Println()
+
+		if flagged > 0 {
+			fmt.Printf("⚠️  %d service(s) rely on defaults that might be too low\n", flagged)
+			fmt.Println("💡 Tip: Set them under 'ulimits:' in the compose file, e.g. nofile: {soft: 65536, hard: 65536}")
+		}
+	},
+}
+
+// valueOrDash renders an unset configured value
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// valueOrUnknown renders an effective value that could not be read
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return value
+}
+
+func init() {
+	rootCmd.AddCommand(tunablesCmd)
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var unmanagedCmd = &cobra.Command{
+	Use:   "unmanaged",
+	Short: "List running containers outside any registered project",
+	Long: `List running containers whose compose project is not registered in dockyard, grouped by compose project, and standalone containers that were not started by compose.
+Remote projects and spawned stacks are not listed.
+Offers to register the compose projects whose compose files still exist and to stop the standalone containers.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		unmanaged, err := cm.FindUnmanagedContainers()
+		if err != nil {
+			fmt.Printf("Failed to find unmanaged containers: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		if unmanaged.IsEmpty() {
+			fmt.Println("✨ Every running container belongs to a registered project")
+			return
+		}
+
+		displayUnmanaged(unmanaged)
+		registerUnmanagedProjects(unmanaged.Projects)
+		stopStandaloneContainers(cm, unmanaged.Standalone)
+	},
+}
+
+// displayUnmanaged prints the unregistered compose projects and the standalone containers
+func displayUnmanaged(unmanaged *docker.UnmanagedContainers) {
+	if len(unmanaged.Projects) > 0 {
+		fmt.Printf("📦 Compose projects not registered (%d):\n", len(unmanaged.Projects))
+		for _, project := range unmanaged.Projects {
+			location := project.WorkingDir
+			if location == "" {
+				location = "unknown directory"
+			} else if !project.Available {
+				location += " (compose file no longer found there)"
+			}
+			fmt.Printf("\n  🔧 %s — %s\n", project.Project, location)
+			for _, cont := range project.Containers {
+				fmt.Printf("    %-30s %-35s %s\n", cont.Name, cont.Image, cont.Status)
+			}
+		}
+		fmt.Println()
+	}
+
+	if len(unmanaged.Standalone) > 0 {
+		fmt.Printf("🐳 Standalone containers (%d):\n", len(unmanaged.Standalone))
+		for _, cont := range unmanaged.Standalone {
+			fmt.Printf("    %-30s %-35s %s\n", cont.Name, cont.Image, cont.Status)
+		}
+		fmt.Println()
+	}
+}
+
+// registerUnmanagedProjects offers to register the compose projects whose compose files still exist
+func registerUnmanagedProjects(projects []docker.UnmanagedProject) {
+	var candidates []docker.ScannedProject
+	var options []string
+	for _, project := range projects {
+		if !project.Available {
+			continue
+		}
+		candidates = append(candidates, docker.ScannedProject{Path: project.WorkingDir, Name: project.Project})
+		options = append(options, fmt.Sprintf("%s (%s)", project.Project, project.WorkingDir))
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	var chosen []int
+	prompt := &survey.MultiSelect{
+		Message: "Select the compose projects to register:",
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &chosen); err != nil || len(chosen) == 0 {
+		return
+	}
+
+	selected := make([]docker.ScannedProject, len(chosen))
+	for i, index := range chosen {
+		selected[i] = candidates[index]
+	}
+	named, ok := nameScannedProjects(selected)
+	if !ok {
+		return
+	}
+
+	if err := docker.RegisterProjects(named); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+	names := slices.Sorted(maps.Keys(named))
+	fmt.Printf("✅ Registered %d project(s): %s\n", len(named), strings.Join(names, ", "))
+}
+
+// stopStandaloneContainers offers to stop the containers that were not started by compose
+func stopStandaloneContainers(cm *docker.ComposeManager, containers []docker.UnmanagedContainer) {
+	if len(containers) == 0 {
+		return
+	}
+
+	options := make([]string, len(containers))
+	for i, cont := range containers {
+		options[i] = fmt.Sprintf("%s (%s)", cont.Name, cont.Image)
+	}
+
+	var chosen []int
+	prompt := &survey.MultiSelect{
+		Message: "Select the standalone containers to stop:",
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &chosen); err != nil || len(chosen) == 0 {
+		return
+	}
+
+	for _, index := range chosen {
+		cont := containers[index]
+		if err := cm.StopContainer(cont.ID); err != nil {
+			fmt.Printf("❌ Failed to stop %s: %v\n", cont.Name, err)
+			setExitCode(ExitFailure)
+			continue
+		}
+		fmt.Printf("✅ Stopped %s\n", cont.Name)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(unmanagedCmd)
+}
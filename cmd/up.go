@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	followUntilHealthy bool
+	healthyTimeout     time.Duration
+)
+
+var upCmd = &cobra.Command{
+	Use:   "up [project] [service]",
+	Short: "Start a project or a single service",
+	Long: `Create and start the containers of a project, or of a single service when one is given.
+With --follow-until-healthy the service logs are streamed until its healthcheck passes or fails.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if followUntilHealthy && len(args) != 2 {
+			fmt.Println("The --follow-until-healthy flag requires a service")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		switch {
+		case len(args) == 1:
+			err = cm.StartProject(projectDir, docker.StartOptions{
+				Detached: true,
+				Scale:    docker.ProjectsSettings[projectName].Scale,
+			})
+		case followUntilHealthy:
+			err = cm.FollowServiceUntilHealthy(projectDir, args[1], healthyTimeout)
+		default:
+			err = cm.UpServices(projectDir, args[1:])
+		}
+
+		if err != nil {
+			fmt.Printf("❌ Failed to start %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+		}
+	},
+}
+
+func init() {
+	upCmd.Flags().BoolVar(&followUntilHealthy, "follow-until-healthy", false, "Stream the service logs until its healthcheck passes or fails")
+	upCmd.Flags().DurationVar(&healthyTimeout, "timeout", 2*time.Minute, "Maximum time to wait for the service to become healthy")
+	rootCmd.AddCommand(upCmd)
+}
@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+var updateAll bool
+
+// updateWorkers bounds the number of projects pulled concurrently
+const updateWorkers = 4
+
+var updateCmd = &cobra.Command{
+	Use:   "update [project...]",
+	Short: "Pull new images and recreate the affected containers",
+	Long: `Pull the images of the given projects, or of every registered project with --all, and recreate the containers of running projects whose images changed.
+Pulls run in parallel. Registry authentication errors are handled per project without aborting the batch, and a final report lists the projects that changed, were unchanged or failed. Stopped projects keep the new images for their next start.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		var projectNames []string
+		switch {
+		case updateAll && len(args) > 0:
+			fmt.Println("❌ Pass either project names or --all, not both")
+			setExitCode(ExitFailure)
+			return
+		case updateAll:
+			projectNames = docker.GetSortedProjectNames()
+		case len(args) == 0:
+			fmt.Println("❌ Pass the projects to update or --all")
+			setExitCode(ExitFailure)
+			return
+		default:
+			for _, arg := range args {
+				projectName, ok := resolveProjectName(arg)
+				if !ok {
+					return
+				}
+				projectNames = append(projectNames, projectName)
+			}
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		fmt.Printf("📥 Pulling images for %d project(s)...\n", len(projectNames))
+		results := pullProjects(projectNames)
+
+		report := &updateReport{}
+		for i, projectName := range projectNames {
+			report.record(cm, projectName, results[i])
+		}
+		report.print()
+	},
+}
+
+// updateReport collects the outcome of an update across projects
+type updateReport struct {
+	changed    []string
+	unchanged  []string
+	failed     []string
+	authFailed []string
+}
+
+// record handles the pull result of a project: it recreates running projects whose images
+// changed and resolves registry errors interactively
+func (r *updateReport) record(cm *docker.ComposeManager, projectName string, result docker.PullResult) {
+	if result.RegistryError != nil {
+		fmt.Printf("\n❌ %s: registry authentication failed\n", projectName)
+		if err := docker.HandleRegistryError(result.RegistryError, result.Output); err != nil {
+			r.authFailed = append(r.authFailed, projectName)
+			return
+		}
+
+		// Logged in, pull again
+		projectDir, err := utils.ResolveHomeDir(docker.Projects[projectName])
+		if err != nil {
+			r.failed = append(r.failed, projectName)
+			return
+		}
+		r.record(cm, projectName, pullProjectWithTimeout(projectName, projectDir))
+		return
+	}
+	if result.Err != nil {
+		fmt.Printf("\n❌ %s: %v\n", projectName, result.Err)
+		if result.Output != "" {
+			fmt.Printf("   %s\n", strings.ReplaceAll(result.Output, "\n", "\n   "))
+		}
+		r.failed = append(r.failed, projectName)
+		return
+	}
+	if len(result.Changed) == 0 {
+		r.unchanged = append(r.unchanged, projectName)
+		return
+	}
+
+	entry := fmt.Sprintf("%s (%s)", projectName, strings.Join(result.Changed, ", "))
+	if result.Running {
+		applyProjectTimeout(cm, projectName)
+		fmt.Printf("\n♻️  Recreating %s with new images of %s\n", projectName, strings.Join(result.Changed, ", "))
+		projectDir, err := utils.ResolveHomeDir(docker.Projects[projectName])
+		if err == nil {
+			err = cm.StartProject(projectDir, docker.StartOptions{
+				Detached:      true,
+				RemoveOrphans: true,
+				Scale:         docker.ProjectsSettings[projectName].Scale,
+			})
+		}
+		reportOperation(projectName, "update", err)
+		if err != nil {
+			fmt.Printf("❌ Failed to recreate %s: %v\n", projectName, err)
+			r.failed = append(r.failed, projectName)
+			return
+		}
+		applyPendingLimits(cm, projectName, projectDir)
+	} else {
+		entry += ", not running"
+	}
+	r.changed = append(r.changed, entry)
+}
+
+// print shows the final report and sets the exit code when a project failed
+func (r *updateReport) print() {
+	total := len(r.changed) + len(r.unchanged) + len(r.failed) + len(r.authFailed)
+	fmt.Printf("\n📊 Summary: %d/%d projects updated successfully\n", len(r.changed)+len(r.unchanged), total)
+	for _, projectName := range r.changed {
+		fmt.Printf("   🆕 %s\n", projectName)
+	}
+	for _, projectName := range r.unchanged {
+		fmt.Printf("   ✅ %s (up to date)\n", projectName)
+	}
+	for _, projectName := range r.authFailed {
+		fmt.Printf("   🔐 %s (authentication required)\n", projectName)
+	}
+	for _, projectName := range r.failed {
+		fmt.Printf("   ❌ %s\n", projectName)
+	}
+
+	switch {
+	case len(r.authFailed) > 0 && len(r.failed) == 0:
+		setExitCode(ExitAuthRequired)
+	case len(r.failed) > 0 || len(r.authFailed) > 0:
+		setExitCode(ExitFailure)
+	}
+}
+
+// pullProjects pulls the images of the projects concurrently. Results keep the input order.
+func pullProjects(projectNames []string) []docker.PullResult {
+	results := make([]docker.PullResult, len(projectNames))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < updateWorkers && w < len(projectNames); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				projectDir, err := utils.ResolveHomeDir(docker.Projects[projectNames[i]])
+				if err != nil {
+					results[i] = docker.PullResult{Err: fmt.Errorf("failed to resolve path: %v", err)}
+					continue
+				}
+				results[i] = pullProjectWithTimeout(projectNames[i], projectDir)
+				if results[i].Err != nil {
+					fmt.Printf("   ❌ %s\n", projectNames[i])
+				} else {
+					fmt.Printf("   📦 %s\n", projectNames[i])
+				}
+			}
+		}()
+	}
+
+	for i := range projectNames {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// pullProjectWithTimeout pulls a project with its own compose manager, so that concurrent pulls
+// each use their project's timeout
+func pullProjectWithTimeout(projectName, projectDir string) docker.PullResult {
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		return docker.PullResult{Err: fmt.Errorf("failed to create compose manager: %v", err)}
+	}
+	defer cm.Close()
+
+	applyProjectTimeout(cm, projectName)
+	return cm.PullProjectQuietly(projectDir)
+}
+
+func init() {
+	updateCmd.Flags().BoolVar(&updateAll, "all", false, "Update every registered project")
+	rootCmd.AddCommand(updateCmd)
+}
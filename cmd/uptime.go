@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var uptimeCmd = &cobra.Command{
+	Use:   "uptime [project]",
+	Short: "Show how long each container has been running",
+	Long:  `Display the uptime and restart count of each container in a project, most recently started first, to spot containers that restarted recently.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		uptimes, err := cm.GetProjectUptimes(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get uptime for project %s: %v\n", projectName, err)
+			return
+		}
+
+		if len(uptimes) == 0 {
+			fmt.Printf("📭 No containers found for project '%s'\n", projectName)
+			return
+		}
+
+		fmt.Printf("⏱️  Uptime for project '%s':\n", projectName)
+		fmt.Printf("%-25s %-12s %-12s %s\n", "SERVICE", "STATE", "UPTIME", "RESTARTS")
+		fmt.Println(strings.Repeat("-", 60))
+
+		for _, uptime := range uptimes {
+			fmt.Printf("%-25s %s%-9s %-12s %d\n",
+				uptime.Service,
+				getStateEmoji(uptime.State),
+				uptime.State,
+				formatUptime(uptime),
+				uptime.RestartCount)
+		}
+	},
+}
+
+func formatUptime(uptime docker.ContainerUptime) string {
+	if uptime.State != "running" {
+		return "-"
+	}
+	return docker.FormatDuration(uptime.Uptime())
+}
+
+func init() {
+	rootCmd.AddCommand(uptimeCmd)
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+	"sync"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/spf13/cobra"
+)
+
+var showDeprecations bool
+var validateAll bool
+
+// validateWorkers bounds the number of projects loaded concurrently
+const validateWorkers = 8
+
+var validateCmd = &cobra.Command{
+	Use:   "validate [project]",
+	Short: "Check that the paths referenced by a compose file exist",
+	Long: `Load the compose file of a project and check that each service's build context, Dockerfile and bind mount sources exist on disk, so that missing paths are reported before running up or build.
+Use --deprecations to also list deprecated compose constructs with migration hints. They are informational and do not change the exit code.
+Use --all instead of a project to validate every registered project in parallel, e.g. after a refactor. The report shows the first error of each failing project and the exit code is non-zero if any fails.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		switch {
+		case validateAll && len(args) > 0:
+			fmt.Println("❌ Pass either a project name or --all, not both")
+			setExitCode(ExitFailure)
+			return
+		case validateAll:
+			validateAllProjects()
+			return
+		case len(args) == 0:
+			fmt.Println("❌ Pass the project to validate or --all")
+			setExitCode(ExitFailure)
+			return
+		}
+
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if showDeprecations {
+			displayDeprecations(projectName, projectDir, project)
+		}
+
+		issues := docker.ValidateProject(project)
+		if len(issues) == 0 {
+			fmt.Println(ui.RenderSuccess(fmt.Sprintf("All paths referenced by %s exist", projectName)))
+			return
+		}
+
+		missing := 0
+		fmt.Printf("🔍 Path issues in project '%s':\n", projectName)
+		for _, issue := range issues {
+			emoji := "⚠️ "
+			if issue.Severity == docker.PathIssueError {
+				emoji = "❌"
+				missing++
+			}
+			fmt.Printf("%s %s: %s %s (%s)\n", emoji, issue.Service, issue.Kind, issue.Path, issue.Detail)
+		}
+		fmt.Println()
+
+		if missing > 0 {
+			fmt.Println(ui.RenderWarning(fmt.Sprintf("%d path(s) are missing, compose will fail on these services", missing)))
+			setExitCode(ExitFailure)
+		}
+	},
+}
+
+// displayDeprecations lists the deprecated constructs of a project's compose file
+func displayDeprecations(projectName, projectDir string, project *types.Project) {
+	deprecations, err := docker.FindDeprecations(projectDir, project)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to check deprecations: %v\n", err)
+		return
+	}
+
+	if len(deprecations) == 0 {
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("%s uses no deprecated compose features", projectName)))
+		fmt.Println()
+		return
+	}
+
+	fmt.Println(ui.RenderWarning(fmt.Sprintf("%d deprecated compose feature(s) in %s:", len(deprecations), projectName)))
+	for _, deprecation := range deprecations {
+		location := "top level"
+		if deprecation.Service != "" {
+			location = deprecation.Service
+		}
+		fmt.Printf("   • %s: %s\n", location, deprecation.Feature)
+		fmt.Printf("     💡 %s\n", deprecation.Hint)
+	}
+	fmt.Println()
+}
+
+// projectValidation holds the outcome of validating a single project
+type projectValidation struct {
+	services int
+	warnings int
+	failure  string
+}
+
+// validateAllProjects loads and validates every registered project concurrently and prints
+// a report with the first error of each failing project
+func validateAllProjects() {
+	projectNames := docker.GetSortedProjectNames()
+	if len(projectNames) == 0 {
+		fmt.Println("📭 No projects registered")
+		return
+	}
+
+	cm, err := docker.NewComposeManager()
+	if err != nil {
+		fmt.Printf("Failed to create compose manager: %v\n", err)
+		setExitCodeForError(err)
+		return
+	}
+	defer cm.Close()
+
+	fmt.Printf("🔍 Validating %d project(s)...\n\n", len(projectNames))
+	results := make([]projectValidation, len(projectNames))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < validateWorkers && w < len(projectNames); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = validateProjectPath(cm, docker.Projects[projectNames[i]])
+			}
+		}()
+	}
+	for i := range projectNames {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := 0
+	for i, projectName := range projectNames {
+		result := results[i]
+		switch {
+		case result.failure != "":
+			failed++
+			fmt.Printf("❌ %-25s %s\n", projectName, result.failure)
+		case result.warnings > 0:
+			fmt.Printf("⚠️  %-25s %d service(s), %d warning(s)\n", projectName, result.services, result.warnings)
+		default:
+			fmt.Printf("✅ %-25s %d service(s)\n", projectName, result.services)
+		}
+	}
+	fmt.Println()
+
+	if failed > 0 {
+		fmt.Println(ui.RenderWarning(fmt.Sprintf("%d of %d project(s) failed to validate", failed, len(projectNames))))
+		fmt.Println("💡 Tip: Run 'dockyard validate [project]' for all issues of a project")
+		setExitCode(ExitFailure)
+		return
+	}
+	fmt.Println(ui.RenderSuccess(fmt.Sprintf("All %d project(s) are valid", len(projectNames))))
+}
+
+// validateProjectPath loads the project at projectPath and checks the paths it references
+func validateProjectPath(cm *docker.ComposeManager, projectPath string) projectValidation {
+	projectDir, err := utils.ResolveHomeDir(projectPath)
+	if err != nil {
+		return projectValidation{failure: fmt.Sprintf("Failed to resolve path: %v", err)}
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return projectValidation{failure: err.Error()}
+	}
+
+	result := projectValidation{services: len(project.Services)}
+	for _, issue := range docker.ValidateProject(project) {
+		if issue.Severity != docker.PathIssueError {
+			result.warnings++
+			continue
+		}
+		if result.failure == "" {
+			result.failure = fmt.Sprintf("%s: %s %s (%s)", issue.Service, issue.Kind, issue.Path, issue.Detail)
+		}
+	}
+	return result
+}
+
+func init() {
+	validateCmd.Flags().BoolVar(&showDeprecations, "deprecations", false, "Also list deprecated compose features")
+	validateCmd.Flags().BoolVar(&validateAll, "all", false, "Validate every registered project")
+	rootCmd.AddCommand(validateCmd)
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/ui"
+	"dockyard/pkg/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var verifyImagesCmd = &cobra.Command{
+	Use:   "verify-images [project]",
+	Short: "Check that every image of a project is present locally",
+	Long: `Check the local image store for the image of each service and report the ones that would need to be pulled, to know upfront whether a project can start without network access.
+Services built from a Dockerfile are listed separately. Use 'dockyard start --offline' to refuse starting when an image is missing.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			setExitCode(ExitFailure)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+		defer cm.Close()
+
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to load project %s: %v\n", projectName, err)
+			setExitCodeForError(err)
+			return
+		}
+
+		images, err := cm.CheckLocalImages(project)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			setExitCodeForError(err)
+			return
+		}
+
+		fmt.Printf("🔍 Local images for project '%s':\n", projectName)
+		missing := 0
+		var built []docker.LocalImage
+		for _, image := range images {
+			if image.Built {
+				built = append(built, image)
+				continue
+			}
+			icon := "✅"
+			if !image.Present {
+				icon = "⬇️ "
+				missing++
+			}
+			fmt.Printf("   %s %-20s %s\n", icon, image.Service, image.Image)
+		}
+
+		if len(built) > 0 {
+			fmt.Println("\n🔨 Built from a Dockerfile:")
+			for _, image := range built {
+				state := "built"
+				if !image.Present {
+					state = "not built yet, building may need network access for base images"
+				}
+				fmt.Printf("   %-20s %s (%s)\n", image.Service, image.Image, state)
+			}
+		}
+		fmt.Println()
+
+		if missing > 0 {
+			fmt.Println(ui.RenderWarning(fmt.Sprintf("%d image(s) would need to be pulled", missing)))
+			setExitCode(ExitFailure)
+			return
+		}
+		fmt.Println(ui.RenderSuccess("Every pulled image is present locally, the project can start offline"))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyImagesCmd)
+}
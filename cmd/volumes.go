@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var volumesCmd = &cobra.Command{
+	Use:   "volumes [project]",
+	Short: "Show the named volumes of a Docker project",
+	Long:  `List the named volumes declared in a project's compose file with their driver, whether they exist on the daemon, their size and the host mountpoint.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			fmt.Printf("Failed to resolve home directory in %s: %v\n", projectPath, err)
+			return
+		}
+
+		if err := docker.CheckDockerStatus(); err != nil {
+			fmt.Printf("❌ Docker status check failed: %v\n", err)
+			return
+		}
+
+		cm, err := docker.NewComposeManager()
+		if err != nil {
+			fmt.Printf("Failed to create compose manager: %v\n", err)
+			return
+		}
+		defer cm.Close()
+
+		volumes, err := cm.GetProjectVolumes(projectDir)
+		if err != nil {
+			fmt.Printf("Failed to get volumes for project %s: %v\n", projectName, err)
+			return
+		}
+
+		if len(volumes) == 0 {
+			fmt.Printf("📭 Project '%s' declares no named volumes\n", projectName)
+			return
+		}
+
+		displayProjectVolumes(projectName, volumes)
+	},
+}
+
+// displayProjectVolumes prints the project volumes as a table
+func displayProjectVolumes(projectName string, volumes []docker.ProjectVolume) {
+	fmt.Printf("💾 Volumes for project '%s':\n", projectName)
+	fmt.Printf("%-30s %-10s %-8s %-10s %s\n", "VOLUME", "DRIVER", "EXISTS", "SIZE", "MOUNTPOINT")
+	fmt.Println(strings.Repeat("-", 100))
+
+	for _, volume := range volumes {
+		name := volume.Name
+		if volume.External {
+			name += " (external)"
+		}
+
+		exists := "❌ no"
+		if volume.Exists {
+			exists = "✅ yes"
+		}
+
+		size := "-"
+		if volume.Size >= 0 {
+			size = docker.FormatBytes(volume.Size)
+		}
+
+		mountpoint := volume.Mountpoint
+		if mountpoint == "" {
+			mountpoint = "-"
+		}
+
+		fmt.Printf("%-30s %-10s %-8s %-10s %s\n", name, volume.Driver, exists, size, mountpoint)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(volumesCmd)
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// webhookURL overrides the DOCKYARD_WEBHOOK_URL environment variable
+var webhookURL string
+
+// webhookDeliveries tracks the webhooks still being delivered in the background
+var webhookDeliveries sync.WaitGroup
+
+// reportOperation records the result of an operation in the history shown by timeline and
+// notifies the webhook
+func reportOperation(projectName, action string, err error) {
+	if recordErr := docker.RecordOperation(projectName, action, err); recordErr != nil {
+		fmt.Printf("⚠️  Failed to record %s in the history: %v\n", action, recordErr)
+	}
+	notifyWebhook(projectName, action, err)
+}
+
+// notifyWebhook reports the result of an operation to the configured webhook, if any, in the
+// background so operations and loops are not held up. Delivery failures are only reported as warnings.
+func notifyWebhook(projectName, action string, err error) {
+	url := webhookURL
+	if url == "" {
+		url = os.Getenv(utils.WebhookURLEnv)
+	}
+	if url == "" {
+		return
+	}
+
+	payload := utils.NewWebhookPayload(projectName, action, err)
+	webhookDeliveries.Add(1)
+	go func() {
+		defer webhookDeliveries.Done()
+		if sendErr := utils.SendWebhook(url, payload); sendErr != nil {
+			fmt.Printf("⚠️  %v\n", sendErr)
+		}
+	}()
+}
+
+// waitWebhooks waits for the webhooks still being delivered before the process exits
+func waitWebhooks() {
+	webhookDeliveries.Wait()
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"dockyard/pkg/docker"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var workdirChange bool
+
+var workdirCmd = &cobra.Command{
+	Use:   "workdir [project]",
+	Short: "Show or change the directory of a project",
+	Long: `Show the stored path of a project, the directory it resolves to and the compose file dockyard uses there.
+When the directory no longer exists or holds no compose file, or with --change, browse for the new directory of the project. Its settings, aliases and pins are kept, so a moved project does not need to be removed and added again.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectName, ok := resolveProjectName(args[0])
+		if !ok {
+			return
+		}
+		projectPath := docker.Projects[projectName]
+
+		fmt.Printf("📁 Project '%s'\n", projectName)
+		fmt.Printf("   Stored path:  %s\n", projectPath)
+
+		healthy := showProjectWorkdir(projectPath)
+		if !healthy && !workdirChange {
+			change := false
+			prompt := &survey.Confirm{
+				Message: "The project directory looks broken. Choose a new directory?",
+				Default: true,
+			}
+			if err := survey.AskOne(prompt, &change); err != nil || !change {
+				setExitCode(ExitFailure)
+				return
+			}
+		} else if !workdirChange {
+			return
+		}
+
+		changeProjectWorkdir(projectName)
+	},
+}
+
+// showProjectWorkdir prints the resolved directory and compose file of a project path, returning
+// false when the directory is missing or has no compose file
+func showProjectWorkdir(projectPath string) bool {
+	if utils.IsRemoteSource(projectPath) {
+		fmt.Println("   Remote source, fetched into a cache directory on use")
+		return true
+	}
+
+	projectDir, err := utils.ResolveHomeDir(projectPath)
+	if err != nil {
+		fmt.Printf("   ❌ Failed to resolve home directory: %v\n", err)
+		return false
+	}
+	fmt.Printf("   Directory:    %s\n", projectDir)
+
+	if info, err := os.Stat(projectDir); err != nil || !info.IsDir() {
+		fmt.Println("   ❌ The directory does not exist")
+		return false
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+		return false
+	}
+	fmt.Printf("   Compose file: %s\n", composeFilePath)
+	return true
+}
+
+// changeProjectWorkdir browses for a new directory and stores it once confirmed
+func changeProjectWorkdir(projectName string) {
+	fmt.Println("Browse to select the new project directory:")
+	newPath, err := docker.BrowseForProjectPath()
+	if err != nil {
+		fmt.Printf("❌ Failed to browse for project path: %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+
+	if docker.HasDockerFiles(newPath) {
+		fmt.Printf("✅ Found Docker files: %s\n", docker.GetDockerFilesInfo(newPath))
+	} else {
+		fmt.Printf("⚠️  Warning: No Docker files found in %s\n", newPath)
+	}
+
+	confirm := false
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("Move project '%s' to '%s'?", projectName, newPath),
+		Default: false,
+	}
+	if err := survey.AskOne(prompt, &confirm); err != nil || !confirm {
+		fmt.Println("Path change cancelled.")
+		return
+	}
+
+	if err := docker.SetProjectPath(projectName, newPath); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		setExitCode(ExitFailure)
+		return
+	}
+	fmt.Printf("✅ Project '%s' now uses %s\n", projectName, newPath)
+}
+
+func init() {
+	workdirCmd.Flags().BoolVar(&workdirChange, "change", false, "Browse for a new project directory")
+	rootCmd.AddCommand(workdirCmd)
+}
@@ -0,0 +1,100 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+)
+
+// DefaultDetachKeys is the key sequence docker uses to detach from a container
+const DefaultDetachKeys = "ctrl-p,ctrl-q"
+
+// AttachTarget is the running container of a service that AttachToService connects to
+type AttachTarget struct {
+	Container string
+	// TTY reports whether the container has a terminal, which the detach key sequence requires
+	TTY bool
+}
+
+// FindAttachTarget returns the first running container of a service, failing when the service
+// is unknown or not running
+func (cm *ComposeManager) FindAttachTarget(projectDir, service string) (AttachTarget, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return AttachTarget{}, err
+	}
+	if _, err := project.GetService(service); err != nil {
+		return AttachTarget{}, fmt.Errorf("service %s not found in project %s", service, project.Name)
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return AttachTarget{}, err
+	}
+
+	for _, cont := range containers {
+		if cont.Labels[LabelComposeService] != service || cont.State != "running" {
+			continue
+		}
+
+		target := AttachTarget{Container: strings.TrimPrefix(cont.Names[0], "/")}
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err != nil {
+			return AttachTarget{}, fmt.Errorf("failed to inspect container %s: %v", target.Container, err)
+		}
+		target.TTY = inspect.Config != nil && inspect.Config.Tty
+		return target, nil
+	}
+
+	return AttachTarget{}, fmt.Errorf("service %s has no running container", service)
+}
+
+// AttachToService connects the terminal to the stdio of the main process of a service's
+// container until the detach keys are pressed. On an interactive terminal a container with a
+// TTY is attached in raw mode and resized with the terminal. Otherwise docker attach is used
+// without proxying signals, so Ctrl-C detaches instead of stopping the process.
+func (cm *ComposeManager) AttachToService(projectDir, service, detachKeys string) error {
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	if detachKeys == "" {
+		detachKeys = DefaultDetachKeys
+	}
+	if err := ValidateDetachKeys(detachKeys); err != nil {
+		return err
+	}
+
+	target, err := cm.FindAttachTarget(projectDir, service)
+	if err != nil {
+		return err
+	}
+
+	if target.TTY && IsInteractiveTerminal() {
+		stream, err := cm.dockerClient.ContainerAttach(cm.ctx, target.Container, types.ContainerAttachOptions{
+			Stream:     true,
+			Stdin:      true,
+			Stdout:     true,
+			Stderr:     true,
+			DetachKeys: detachKeys,
+		})
+		if err == nil {
+			defer stream.Close()
+			return streamTerminal(stream, func(height, width uint) error {
+				return cm.dockerClient.ContainerResize(cm.ctx, target.Container, types.ResizeOptions{Height: height, Width: width})
+			})
+		}
+	}
+
+	cmd := exec.Command(CommandDocker, "attach", "--sig-proxy=false", "--detach-keys", detachKeys, target.Container)
+	cmd.Dir = projectDir
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("attach to %s ended: %v", target.Container, err)
+	}
+	return nil
+}
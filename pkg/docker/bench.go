@@ -0,0 +1,119 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultBenchTimeout bounds how long a benchmark run waits for the services to be up
+const DefaultBenchTimeout = 5 * time.Minute
+
+// benchPollInterval is how often service readiness is sampled while benchmarking
+const benchPollInterval = 250 * time.Millisecond
+
+// BenchRun holds the timings of one down and up cycle of a project
+type BenchRun struct {
+	// Down is the time taken to take the project down
+	Down time.Duration
+	// Ready maps each service to the time from the start of up until it was up
+	Ready map[string]time.Duration
+	// Total is the time from the start of up until every service was up
+	Total time.Duration
+}
+
+// BenchStartup takes a project down and starts it again, measuring the time until each service is
+// up, which is healthy for services that define a healthcheck and running otherwise. Readiness is
+// sampled while compose is still starting the project, so services that dependencies wait for are
+// timed as well. Compose output is only shown when a command fails.
+func (cm *ComposeManager) BenchStartup(projectName, projectDir string, timeout time.Duration) (*BenchRun, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	upArgs, err := upArguments(composeFilePath, project, StartOptions{
+		Detached:      true,
+		RemoveOrphans: true,
+		Scale:         ProjectsSettings[projectName].Scale,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	run := &BenchRun{Ready: make(map[string]time.Duration, len(project.Services))}
+
+	downStart := time.Now()
+	if err := cm.runQuiet(projectDir, "down", downArguments(composeFilePath, false, false)...); err != nil {
+		return nil, err
+	}
+	run.Down = time.Since(downStart)
+
+	start := time.Now()
+	upDone := make(chan error, 1)
+	go func() {
+		upDone <- cm.runQuiet(projectDir, "up", upArgs...)
+	}()
+
+	upFinished := false
+	for {
+		select {
+		case err := <-upDone:
+			if err != nil {
+				return nil, err
+			}
+			upFinished = true
+		default:
+		}
+
+		states, err := cm.GetServiceReadiness(project)
+		if err != nil {
+			return nil, err
+		}
+		elapsed := time.Since(start)
+
+		var failed []string
+		for service, state := range states {
+			if _, ok := run.Ready[service]; ok {
+				continue
+			}
+			switch {
+			case IsReadyState(state):
+				run.Ready[service] = elapsed
+			case upFinished && IsFailedState(state):
+				failed = append(failed, service)
+			}
+		}
+
+		switch {
+		case len(failed) > 0:
+			return nil, fmt.Errorf("service(s) failed to start: %s", strings.Join(failed, ", "))
+		case upFinished && len(run.Ready) == len(states):
+			run.Total = elapsed
+			return run, nil
+		case elapsed > timeout:
+			return nil, fmt.Errorf("timed out after %s with %d/%d service(s) up", timeout, len(run.Ready), len(states))
+		}
+
+		time.Sleep(benchPollInterval)
+	}
+}
+
+// runQuiet runs a docker compose command, returning its output in the error when it fails
+func (cm *ComposeManager) runQuiet(workingDir, action string, args ...string) error {
+	cmd, ctx, cancel := cm.dockerCommand(workingDir, args...)
+	defer cancel()
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if timeoutErr := cm.timeoutError(ctx, args); timeoutErr != nil {
+			return timeoutErr
+		}
+		return fmt.Errorf("docker compose %s failed: %v\n%s", action, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package docker
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// BuildCacheReport summarizes the BuildKit cache records attributed to a project
+type BuildCacheReport struct {
+	Records     []*dockertypes.BuildCache
+	TotalSize   int64
+	Reclaimable int64
+	// BuildKitInUse is false when the daemon reports no build cache at all, which is the
+	// case with the legacy builder
+	BuildKitInUse bool
+}
+
+// RecordIDs returns the IDs of the records that can be pruned
+func (r BuildCacheReport) RecordIDs() []string {
+	var ids []string
+	for _, record := range r.Records {
+		if !record.InUse {
+			ids = append(ids, record.ID)
+		}
+	}
+	return ids
+}
+
+// GetProjectBuildCache returns the build cache records produced by the RUN instructions of
+// the project's Dockerfiles. BuildKit does not label cache records with the image they were
+// built for, so records are matched by the command recorded in their description.
+func (cm *ComposeManager) GetProjectBuildCache(projectDir string) (*BuildCacheReport, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var commands []string
+	for _, service := range project.Services {
+		if service.Build == nil {
+			continue
+		}
+		commands = append(commands, dockerfileRunCommands(service.Build.Context, service.Build.Dockerfile)...)
+	}
+
+	usage, err := cm.dockerClient.DiskUsage(cm.ctx, dockertypes.DiskUsageOptions{
+		Types: []dockertypes.DiskUsageObject{dockertypes.BuildCacheObject},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build cache usage: %v", err)
+	}
+
+	report := &BuildCacheReport{BuildKitInUse: len(usage.BuildCache) > 0}
+	for _, record := range usage.BuildCache {
+		description := normalizeCommand(record.Description)
+		for _, command := range commands {
+			if strings.Contains(description, command) {
+				report.Records = append(report.Records, record)
+				report.TotalSize += record.Size
+				if !record.InUse {
+					report.Reclaimable += record.Size
+				}
+				break
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// PruneBuildCache removes the build cache records with the given IDs and returns the reclaimed space
+func (cm *ComposeManager) PruneBuildCache(ids []string) (uint64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	args := filters.NewArgs()
+	for _, id := range ids {
+		args.Add("id", id)
+	}
+
+	report, err := cm.dockerClient.BuildCachePrune(cm.ctx, dockertypes.BuildCachePruneOptions{All: true, Filters: args})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune build cache: %v", err)
+	}
+	return report.SpaceReclaimed, nil
+}
+
+// dockerfileRunCommands returns the normalized commands of the RUN instructions of a Dockerfile
+func dockerfileRunCommands(context, dockerfile string) []string {
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	if !filepath.IsAbs(dockerfile) {
+		dockerfile = filepath.Join(context, dockerfile)
+	}
+
+	file, err := os.Open(dockerfile)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var commands []string
+	var instruction strings.Builder
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Join continuation lines into a single instruction
+		if strings.HasSuffix(line, "\\") {
+			instruction.WriteString(strings.TrimSuffix(line, "\\") + " ")
+			continue
+		}
+		instruction.WriteString(line)
+
+		fields := strings.Fields(instruction.String())
+		instruction.Reset()
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "RUN") {
+			continue
+		}
+
+		// Skip RUN flags such as --mount=type=cache
+		args := fields[1:]
+		for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+			args = args[1:]
+		}
+		if len(args) > 0 {
+			commands = append(commands, normalizeCommand(strings.Join(args, " ")))
+		}
+	}
+
+	return commands
+}
+
+// normalizeCommand collapses whitespace so commands compare equal regardless of formatting
+func normalizeCommand(command string) string {
+	return strings.Join(strings.Fields(command), " ")
+}
@@ -0,0 +1,180 @@
+package docker
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// FieldDiff represents the values of a service field that differ between two projects
+type FieldDiff struct {
+	Field string
+	OnlyA []string
+	OnlyB []string
+}
+
+// ServiceDiff represents how a service differs between two projects
+type ServiceDiff struct {
+	Service string
+	InA     bool
+	InB     bool
+	Fields  []FieldDiff
+}
+
+// CompareProjects diffs the image, ports, environment keys and volumes of the services of two projects
+func CompareProjects(projectA, projectB *types.Project) []ServiceDiff {
+	servicesA := servicesByName(projectA)
+	servicesB := servicesByName(projectB)
+	workingDirs := [2]string{projectA.WorkingDir, projectB.WorkingDir}
+
+	names := make(map[string]bool)
+	for name := range servicesA {
+		names[name] = true
+	}
+	for name := range servicesB {
+		names[name] = true
+	}
+
+	var sortedNames []string
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	var diffs []ServiceDiff
+	for _, name := range sortedNames {
+		serviceA, inA := servicesA[name]
+		serviceB, inB := servicesB[name]
+
+		diff := ServiceDiff{Service: name, InA: inA, InB: inB}
+		if inA && inB {
+			diff.Fields = compareServices(serviceA, serviceB, workingDirs)
+			if len(diff.Fields) == 0 {
+				continue
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+func servicesByName(project *types.Project) map[string]types.ServiceConfig {
+	services := make(map[string]types.ServiceConfig, len(project.Services))
+	for _, service := range project.Services {
+		services[service.Name] = service
+	}
+	return services
+}
+
+func compareServices(a, b types.ServiceConfig, workingDirs [2]string) []FieldDiff {
+	fields := []struct {
+		name string
+		a, b []string
+	}{
+		{"image", serviceImage(a, workingDirs[0]), serviceImage(b, workingDirs[1])},
+		{"ports", servicePorts(a), servicePorts(b)},
+		{"environment", environmentKeys(a), environmentKeys(b)},
+		{"volumes", serviceVolumes(a, workingDirs[0]), serviceVolumes(b, workingDirs[1])},
+	}
+
+	var diffs []FieldDiff
+	for _, field := range fields {
+		onlyA, onlyB := setDifference(field.a, field.b)
+		if len(onlyA) > 0 || len(onlyB) > 0 {
+			diffs = append(diffs, FieldDiff{Field: field.name, OnlyA: onlyA, OnlyB: onlyB})
+		}
+	}
+	return diffs
+}
+
+// serviceImage returns the image of a service, or its build context relative to the project
+func serviceImage(service types.ServiceConfig, workingDir string) []string {
+	if service.Image != "" {
+		return []string{service.Image}
+	}
+	if service.Build != nil {
+		return []string{fmt.Sprintf("build: %s", relativeToProject(service.Build.Context, workingDir))}
+	}
+	return nil
+}
+
+func servicePorts(service types.ServiceConfig) []string {
+	var ports []string
+	for _, port := range service.Ports {
+		published := port.Published
+		if published == "" {
+			published = "-"
+		}
+		ports = append(ports, fmt.Sprintf("%s:%d/%s", published, port.Target, port.Protocol))
+	}
+	return ports
+}
+
+func environmentKeys(service types.ServiceConfig) []string {
+	var keys []string
+	for key := range service.Environment {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// serviceVolumes lists the mounts of a service, with bind mounts inside the project shown
+// relative to it so that identical stacks living in different directories compare equal
+func serviceVolumes(service types.ServiceConfig, workingDir string) []string {
+	var volumes []string
+	for _, volume := range service.Volumes {
+		source := volume.Source
+		if volume.Type == types.VolumeTypeBind {
+			source = relativeToProject(source, workingDir)
+		}
+		volumes = append(volumes, fmt.Sprintf("%s:%s", source, volume.Target))
+	}
+	return volumes
+}
+
+// relativeToProject shows a path inside the project directory relative to it, e.g. ./data.
+// Other paths, such as git build contexts, are returned as is.
+func relativeToProject(path, workingDir string) string {
+	if !filepath.IsAbs(path) {
+		return path
+	}
+	rel, err := filepath.Rel(workingDir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return path
+	}
+	if rel == "." {
+		return "."
+	}
+	return "./" + filepath.ToSlash(rel)
+}
+
+// setDifference returns the sorted values only present in a and only present in b
+func setDifference(a, b []string) (onlyA, onlyB []string) {
+	inA := make(map[string]bool, len(a))
+	for _, value := range a {
+		inA[value] = true
+	}
+	inB := make(map[string]bool, len(b))
+	for _, value := range b {
+		inB[value] = true
+	}
+
+	for value := range inA {
+		if !inB[value] {
+			onlyA = append(onlyA, value)
+		}
+	}
+	for value := range inB {
+		if !inA[value] {
+			onlyB = append(onlyB, value)
+		}
+	}
+
+	sort.Strings(onlyA)
+	sort.Strings(onlyB)
+	return onlyA, onlyB
+}
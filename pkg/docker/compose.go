@@ -1,14 +1,23 @@
 package docker
 
 import (
+	"bufio"
 	"context"
 	"dockyard/pkg/utils"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/compose-spec/compose-go/loader"
 	"github.com/compose-spec/compose-go/types"
@@ -20,13 +29,21 @@ import (
 type ComposeManager struct {
 	dockerClient client.APIClient
 	ctx          context.Context
+	// timeout bounds each docker command run by the manager, 0 disables it
+	timeout time.Duration
 }
 
+// OperationTimeout is the default timeout of the docker commands run by new compose managers
+var OperationTimeout time.Duration
+
+// ErrOperationTimeout indicates that a docker command was killed after exceeding its timeout
+var ErrOperationTimeout = errors.New("operation timed out")
+
 func NewComposeManager() (*ComposeManager, error) {
 	ctx := context.Background()
 
 	// Create Docker client
-	dockerClient, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	dockerClient, err := client.NewClientWithOpts(dockerClientOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Docker client: %v", err)
 	}
@@ -34,9 +51,72 @@ func NewComposeManager() (*ComposeManager, error) {
 	return &ComposeManager{
 		dockerClient: dockerClient,
 		ctx:          ctx,
+		timeout:      OperationTimeout,
 	}, nil
 }
 
+// SetOperationTimeout bounds each docker command run by the manager, 0 disables the timeout
+func (cm *ComposeManager) SetOperationTimeout(timeout time.Duration) {
+	cm.timeout = timeout
+}
+
+// dockerCommand prepares a docker command. With a timeout the command runs in its own process
+// group, which is killed with all its children once the deadline passes. Since the terminal no
+// longer delivers Ctrl-C to it, interrupts are forwarded so compose can stop gracefully. The
+// returned function releases the context.
+func (cm *ComposeManager) dockerCommand(workingDir string, args ...string) (*exec.Cmd, context.Context, context.CancelFunc) {
+	return cm.dockerCommandUntil(time.Now().Add(cm.timeout), workingDir, args...)
+}
+
+// dockerCommandUntil prepares a docker command like dockerCommand, killed at the given deadline
+// instead of a full timeout from now
+func (cm *ComposeManager) dockerCommandUntil(deadline time.Time, workingDir string, args ...string) (*exec.Cmd, context.Context, context.CancelFunc) {
+	if cm.timeout <= 0 {
+		cmd := exec.Command(CommandDocker, args...)
+		cmd.Dir = workingDir
+		return cmd, context.Background(), func() {}
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	cmd := exec.CommandContext(ctx, CommandDocker, args...)
+	cmd.Dir = workingDir
+	cmd.SysProcAttr = utils.ProcessGroupAttr()
+	cmd.Cancel = func() error {
+		return utils.KillProcessGroup(cmd.Process)
+	}
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	go func() {
+		for {
+			select {
+			case <-interrupts:
+				if cmd.Process == nil {
+					// Not started yet, make sure it never does
+					cancel()
+					return
+				}
+				_ = utils.InterruptProcessGroup(cmd.Process)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cmd, ctx, func() {
+		signal.Stop(interrupts)
+		cancel()
+	}
+}
+
+// timeoutError returns an ErrOperationTimeout error when ctx expired, and nil otherwise
+func (cm *ComposeManager) timeoutError(ctx context.Context, args []string) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	return fmt.Errorf("%w: docker %s was killed after %s", ErrOperationTimeout, strings.Join(args, " "), cm.timeout)
+}
+
 func (cm *ComposeManager) Close() error {
 	if cm.dockerClient != nil {
 		return cm.dockerClient.Close()
@@ -55,8 +135,23 @@ func (cm *ComposeManager) ensureDockerRunning() error {
 	return dhc.CheckDockerDaemon()
 }
 
-// LoadProject loads a Docker Compose project from the project directory
+// LoadProject loads a Docker Compose project from the project directory, fetching it first when
+// it comes from a remote compose source
 func (cm *ComposeManager) LoadProject(projectDir string) (*types.Project, error) {
+	return cm.loadProjectFiles(projectDir, nil)
+}
+
+// loadProjectFiles loads a project with extra compose files merged over its compose file, in order
+func (cm *ComposeManager) loadProjectFiles(projectDir string, extraFiles []string) (*types.Project, error) {
+	if err := utils.FetchRemoteProject(projectDir); err != nil {
+		return nil, err
+	}
+	return cm.loadLocalProject(projectDir, extraFiles)
+}
+
+// loadLocalProject loads a project from the files on disk, using the cached copy of a remote
+// compose source as is
+func (cm *ComposeManager) loadLocalProject(projectDir string, extraFiles []string) (*types.Project, error) {
 	composeFilePath, err := utils.GetComposeFilePath(projectDir)
 	if err != nil {
 		return nil, err
@@ -65,6 +160,11 @@ func (cm *ComposeManager) LoadProject(projectDir string) (*types.Project, error)
 	// Get project name from directory
 	projectName := strings.ToLower(filepath.Base(projectDir))
 
+	// Check includes first, the loader reports missing or circular ones without the chain
+	if _, err := utils.ComposeIncludes(composeFilePath); err != nil {
+		return nil, err
+	}
+
 	// Read the compose file
 	composeContent, err := os.ReadFile(composeFilePath)
 	if err != nil {
@@ -82,6 +182,13 @@ func (cm *ComposeManager) LoadProject(projectDir string) (*types.Project, error)
 		},
 		Environment: make(map[string]string),
 	}
+	for _, file := range extraFiles {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read compose file: %v", err)
+		}
+		configDetails.ConfigFiles = append(configDetails.ConfigFiles, types.ConfigFile{Filename: file, Content: content})
+	}
 
 	// Load environment variables
 	for _, env := range os.Environ() {
@@ -117,45 +224,153 @@ func (cm *ComposeManager) GetProjectContainers(projectName string) ([]dockertype
 		Filters: filterArgs,
 	})
 	if err != nil {
+		if versionErr := cm.apiVersionError(err); versionErr != nil {
+			return nil, versionErr
+		}
 		return nil, fmt.Errorf("failed to list containers: %v", err)
 	}
 
 	return containers, nil
 }
 
-// StartProject starts all services in the project using docker-compose command
-func (cm *ComposeManager) StartProject(projectDir string, detached bool, removeOrphans bool) error {
+// apiVersionPattern matches the errors the daemon returns for an unsupported API version
+var apiVersionPattern = regexp.MustCompile(`(?i)client version [0-9.]+ is too (new|old)|(maximum|minimum) supported API version is [0-9.]+`)
+
+// apiVersionError returns a descriptive error wrapping ErrAPIVersionMismatch when err is caused
+// by an API version the daemon does not support, and nil otherwise
+func (cm *ComposeManager) apiVersionError(err error) error {
+	if !apiVersionPattern.MatchString(err.Error()) {
+		return nil
+	}
+	return fmt.Errorf("%w: dockyard uses Docker API version %s (%v). Pin a version the daemon supports with --api-version or DOCKER_API_VERSION",
+		ErrAPIVersionMismatch, cm.dockerClient.ClientVersion(), err)
+}
+
+// StartOptions configures how StartProject brings a project up
+type StartOptions struct {
+	Detached      bool
+	RemoveOrphans bool
+	// Scale maps a service name to the number of replicas to start
+	Scale map[string]int
+	// Services limits the start to the given services, all services are started when empty
+	Services []string
+	// NoDeps skips starting the dependencies of Services
+	NoDeps bool
+	// Offline refuses to start when an image is missing locally and never pulls
+	Offline bool
+	// Profiles are the compose profiles to activate besides the services without a profile
+	Profiles []string
+	// Overlays are extra compose files merged over the project's compose file, in order
+	Overlays []string
+}
+
+// StartProject starts the services of the project using docker-compose command
+func (cm *ComposeManager) StartProject(projectDir string, options StartOptions) error {
 	// Check Docker health first
 	if err := CheckDockerStatus(); err != nil {
 		return err
 	}
 
-	project, err := cm.LoadProject(projectDir)
+	project, err := cm.loadProjectFiles(projectDir, options.Overlays)
 	if err != nil {
 		return err
 	}
+	if len(options.Profiles) > 0 {
+		project.ApplyProfiles(options.Profiles)
+	}
 
-	fmt.Printf("🚀 Starting project: %s\n", project.Name)
+	if options.Offline {
+		if err := cm.requireLocalImages(project); err != nil {
+			return err
+		}
+	}
 
-	// Build docker-compose command
-	args := []string{"compose", "-f"}
+	fmt.Printf("🚀 Starting project: %s\n", project.Name)
 
 	composeFilePath, err := utils.GetComposeFilePath(projectDir)
 	if err != nil {
 		return err
 	}
-	args = append(args, composeFilePath)
 
+	args, err := upArguments(composeFilePath, project, options)
+	if err != nil {
+		return err
+	}
+
+	err = cm.executeCommandWithErrorHandling(projectDir, args...)
+	var conflict *NetworkConflictError
+	if errors.As(err, &conflict) && cm.resolveNetworkConflict(conflict.Network) {
+		fmt.Printf("🔁 Retrying start of project: %s\n", project.Name)
+		err = cm.executeCommandWithErrorHandling(projectDir, args...)
+	}
+	return err
+}
+
+// upArguments returns the docker compose up arguments that start a project with options
+func upArguments(composeFilePath string, project *types.Project, options StartOptions) ([]string, error) {
+	args := utils.ComposeArgs(composeFilePath)
+	for _, overlay := range options.Overlays {
+		args = append(args, "-f", overlay)
+	}
+	for _, profile := range options.Profiles {
+		args = append(args, "--profile", profile)
+	}
 	args = append(args, "up")
 
-	if detached {
+	if options.Detached {
 		args = append(args, "-d")
 	}
-	if removeOrphans {
+	if options.RemoveOrphans {
 		args = append(args, "--remove-orphans")
 	}
+	if options.Offline {
+		args = append(args, "--pull", "never")
+	}
 
-	return cm.executeCommandWithErrorHandling(projectDir, args...)
+	scaleArgs, err := scaleArguments(project, options.Scale)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, scaleArgs...)
+
+	if options.NoDeps {
+		if len(options.Services) == 0 {
+			return nil, fmt.Errorf("--no-deps requires specific services to start")
+		}
+		args = append(args, "--no-deps")
+	}
+
+	for _, service := range options.Services {
+		if _, err := project.GetService(service); err != nil {
+			return nil, fmt.Errorf("service %s not found in project %s", service, project.Name)
+		}
+	}
+	return append(args, options.Services...), nil
+}
+
+// ScaleService sets the number of replicas of a service without recreating the others
+func (cm *ComposeManager) ScaleService(projectDir string, service string, replicas int) error {
+	fmt.Printf("📐 Scaling service %s to %d replica(s)\n", service, replicas)
+	return cm.executeServiceCommand(projectDir, []string{"up", "-d", "--no-recreate", "--scale", fmt.Sprintf("%s=%d", service, replicas)}, []string{service})
+}
+
+// scaleArguments returns the --scale arguments for the given replica counts, rejecting
+// services the project does not define
+func scaleArguments(project *types.Project, scale map[string]int) ([]string, error) {
+	services := make([]string, 0, len(scale))
+	for service := range scale {
+		if _, err := project.GetService(service); err != nil {
+			return nil, fmt.Errorf("scale default for unknown service %s in project %s", service, project.Name)
+		}
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	var args []string
+	for _, service := range services {
+		args = append(args, "--scale", fmt.Sprintf("%s=%d", service, scale[service]))
+	}
+	return args, nil
 }
 
 // StopProject stops all services in the project
@@ -172,15 +387,22 @@ func (cm *ComposeManager) StopProject(projectDir string, removeVolumes bool, rem
 
 	fmt.Printf("⏹️  Stopping project: %s\n", project.Name)
 
-	args := []string{"compose", "-f"}
-
 	composeFilePath, err := utils.GetComposeFilePath(projectDir)
 	if err != nil {
 		return err
 	}
-	args = append(args, composeFilePath)
 
-	args = append(args, "down")
+	if err := cm.executeCommandWithErrorHandling(projectDir, downArguments(composeFilePath, removeVolumes, removeImages)...); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Successfully stopped project: %s\n", project.Name)
+	return nil
+}
+
+// downArguments returns the docker compose down arguments that stop a project
+func downArguments(composeFilePath string, removeVolumes bool, removeImages bool) []string {
+	args := utils.ComposeArgs(composeFilePath, "down")
 
 	if removeVolumes {
 		args = append(args, "-v")
@@ -188,17 +410,56 @@ func (cm *ComposeManager) StopProject(projectDir string, removeVolumes bool, rem
 	if removeImages {
 		args = append(args, "--rmi", "local")
 	}
+	return args
+}
+
+// RestartOptions controls how services are restarted. Compose restart does not recreate
+// containers, so configuration changes are not applied.
+type RestartOptions struct {
+	// NoDeps restarts only the given services and not the services depending on them
+	NoDeps bool
+	// Timeout is the grace period before stopping containers are killed, compose's default when nil
+	Timeout *time.Duration
+}
 
+// RestartProject restarts all services in the project
+func (cm *ComposeManager) RestartProject(projectDir string, options RestartOptions) error {
+	// Reject invalid options before checking Docker
+	if _, err := restartCommand(nil, options); err != nil {
+		return err
+	}
+
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔄 Restarting project: %s\n", project.Name)
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	args, err := restartArguments(composeFilePath, nil, options)
+	if err != nil {
+		return err
+	}
 	if err := cm.executeCommandWithErrorHandling(projectDir, args...); err != nil {
 		return err
 	}
 
-	fmt.Printf("✅ Successfully stopped project: %s\n", project.Name)
+	fmt.Printf("✅ Successfully restarted project: %s\n", project.Name)
 	return nil
 }
 
-// RestartProject restarts all services in the project
-func (cm *ComposeManager) RestartProject(projectDir string) error {
+// CreateProject creates the containers of all services in the project without starting them
+func (cm *ComposeManager) CreateProject(projectDir string) error {
 	// Check Docker health first
 	if err := CheckDockerStatus(); err != nil {
 		return err
@@ -209,21 +470,53 @@ func (cm *ComposeManager) RestartProject(projectDir string) error {
 		return err
 	}
 
-	fmt.Printf("🔄 Restarting project: %s\n", project.Name)
+	fmt.Printf("🧱 Creating containers for project: %s\n", project.Name)
 
 	composeFilePath, err := utils.GetComposeFilePath(projectDir)
 	if err != nil {
 		return err
 	}
 
-	if err := cm.executeCommandWithErrorHandling(projectDir, "compose", "-f", composeFilePath, "restart"); err != nil {
+	if err := cm.executeCommandWithErrorHandling(projectDir, utils.ComposeArgs(composeFilePath, "create")...); err != nil {
 		return err
 	}
 
-	fmt.Printf("✅ Successfully restarted project: %s\n", project.Name)
+	fmt.Printf("✅ Successfully created containers for project: %s\n", project.Name)
 	return nil
 }
 
+// restartArguments returns the docker compose restart arguments that restart the given services,
+// or the whole project when none are given
+func restartArguments(composeFilePath string, services []string, options RestartOptions) ([]string, error) {
+	command, err := restartCommand(services, options)
+	if err != nil {
+		return nil, err
+	}
+	args := utils.ComposeArgs(composeFilePath, command...)
+	return append(args, services...), nil
+}
+
+// restartCommand returns the restart subcommand with its flags, validating them against the services
+func restartCommand(services []string, options RestartOptions) ([]string, error) {
+	command := []string{"restart"}
+
+	if options.NoDeps {
+		if len(services) == 0 {
+			return nil, fmt.Errorf("--no-deps requires specific services to restart")
+		}
+		command = append(command, "--no-deps")
+	}
+	if options.Timeout != nil {
+		if *options.Timeout < 0 {
+			return nil, fmt.Errorf("invalid timeout %s, it cannot be negative", *options.Timeout)
+		}
+		// Compose takes whole seconds, round up so a short grace period is not turned into none
+		seconds := int((*options.Timeout + time.Second - 1) / time.Second)
+		command = append(command, "-t", strconv.Itoa(seconds))
+	}
+	return command, nil
+}
+
 // PauseProject pauses all services in the project
 func (cm *ComposeManager) PauseProject(projectDir string) error {
 	// Check Docker health first
@@ -243,7 +536,7 @@ func (cm *ComposeManager) PauseProject(projectDir string) error {
 		return err
 	}
 
-	if err := cm.executeCommandWithErrorHandling(projectDir, "compose", "-f", composeFilePath, "pause"); err != nil {
+	if err := cm.executeCommandWithErrorHandling(projectDir, utils.ComposeArgs(composeFilePath, "pause")...); err != nil {
 		return err
 	}
 
@@ -270,7 +563,7 @@ func (cm *ComposeManager) UnpauseProject(projectDir string) error {
 		return err
 	}
 
-	if err := cm.executeCommandWithErrorHandling(projectDir, "compose", "-f", composeFilePath, "unpause"); err != nil {
+	if err := cm.executeCommandWithErrorHandling(projectDir, utils.ComposeArgs(composeFilePath, "unpause")...); err != nil {
 		return err
 	}
 
@@ -278,6 +571,265 @@ func (cm *ComposeManager) UnpauseProject(projectDir string) error {
 	return nil
 }
 
+// ProcessList shows the processes running inside each container of the project using
+// docker compose top. Services without a running container are listed first.
+func (cm *ComposeManager) ProcessList(projectDir string) error {
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := cm.GetProjectStatus(projectDir)
+	if err != nil {
+		return err
+	}
+
+	running := make(map[string]bool)
+	for _, status := range statuses {
+		if status.State == "running" {
+			running[status.Service] = true
+		}
+	}
+	var stopped []string
+	for _, service := range project.ServiceNames() {
+		if !running[service] {
+			stopped = append(stopped, service)
+		}
+	}
+	sort.Strings(stopped)
+
+	if len(running) == 0 {
+		fmt.Printf("📭 No running containers in project %s\n", project.Name)
+		return nil
+	}
+	if len(stopped) > 0 {
+		fmt.Printf("⏹️  Not running: %s\n\n", strings.Join(stopped, ", "))
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	return cm.executeCommandWithErrorHandling(projectDir, utils.ComposeArgs(composeFilePath, "top")...)
+}
+
+// StartServices starts specific existing services in the project
+func (cm *ComposeManager) StartServices(projectDir string, services []string) error {
+	fmt.Printf("▶️  Starting services: %s\n", strings.Join(services, ", "))
+	return cm.executeServiceCommand(projectDir, []string{"start"}, services)
+}
+
+// StopServices stops specific services in the project without removing them
+func (cm *ComposeManager) StopServices(projectDir string, services []string) error {
+	fmt.Printf("⏹️  Stopping services: %s\n", strings.Join(services, ", "))
+	return cm.executeServiceCommand(projectDir, []string{"stop"}, services)
+}
+
+// RestartServices restarts specific services in the project
+func (cm *ComposeManager) RestartServices(projectDir string, services []string, options RestartOptions) error {
+	command, err := restartCommand(services, options)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔄 Restarting services: %s\n", strings.Join(services, ", "))
+	return cm.executeServiceCommand(projectDir, command, services)
+}
+
+// RecreateServices recreates the containers of specific services without touching their dependencies
+func (cm *ComposeManager) RecreateServices(projectDir string, services []string) error {
+	fmt.Printf("♻️  Recreating services: %s\n", strings.Join(services, ", "))
+	return cm.executeServiceCommand(projectDir, []string{"up", "-d", "--force-recreate", "--no-deps"}, services)
+}
+
+// executeServiceCommand runs a compose subcommand scoped to the given services
+func (cm *ComposeManager) executeServiceCommand(projectDir string, command []string, services []string) error {
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	if len(services) == 0 {
+		return fmt.Errorf("no services specified")
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	args := utils.ComposeArgs(composeFilePath, command...)
+	args = append(args, services...)
+
+	if err := cm.executeCommandWithErrorHandling(projectDir, args...); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Successfully ran %s for services: %s\n", command[0], strings.Join(services, ", "))
+	return nil
+}
+
+// WatchProject runs `docker compose watch` for the project, syncing and rebuilding services
+// on file changes until interrupted
+func (cm *ComposeManager) WatchProject(projectDir string) error {
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+
+	var watched []string
+	for _, service := range project.Services {
+		if service.Develop != nil && len(service.Develop.Watch) > 0 {
+			watched = append(watched, service.Name)
+		}
+	}
+
+	if len(watched) == 0 {
+		return fmt.Errorf("no service in project %s declares a develop.watch section. Add one to the compose file to use watch mode, see https://docs.docker.com/compose/file-watch/", project.Name)
+	}
+
+	sort.Strings(watched)
+	fmt.Printf("👀 Watching project: %s (services: %s)\n", project.Name, strings.Join(watched, ", "))
+	fmt.Println("   Press Ctrl+C to stop")
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	args := utils.ComposeArgs(composeFilePath, "watch")
+	cmd, ctx, cancel := cm.dockerCommand(projectDir, args...)
+	defer cancel()
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// The child receives Ctrl+C itself, or has it forwarded when it runs in its own process
+	// group, so we only need to keep dockyard alive until it has shut down
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupts)
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start watch: %v", err)
+	}
+
+	err = cmd.Wait()
+	select {
+	case <-interrupts:
+		fmt.Printf("\n✅ Stopped watching project: %s\n", project.Name)
+		return nil
+	default:
+		if timeoutErr := cm.timeoutError(ctx, args); timeoutErr != nil {
+			return timeoutErr
+		}
+		return err
+	}
+}
+
+// ProbeInterval is the delay between readiness probe attempts
+const ProbeInterval = 2 * time.Second
+
+// ProbeResult represents the outcome of a readiness probe
+type ProbeResult struct {
+	Service   string
+	Target    string
+	Ready     bool
+	LastError string
+}
+
+// RunReadinessProbes polls each service probe until it responds or the timeout expires.
+// Targets starting with http:// or https:// are checked with a GET request expecting a
+// 2xx/3xx response, anything else is treated as a TCP address (host:port or a bare port).
+func (cm *ComposeManager) RunReadinessProbes(probes map[string]string, timeout time.Duration) ([]ProbeResult, error) {
+	services := make([]string, 0, len(probes))
+	for service := range probes {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	results := make([]ProbeResult, len(services))
+	for i, service := range services {
+		results[i] = ProbeResult{Service: service, Target: probes[service]}
+	}
+
+	fmt.Printf("⏳ Waiting for %d readiness probe(s) (timeout %s)...\n", len(results), timeout)
+
+	deadline := time.Now().Add(timeout)
+	for {
+		pending := 0
+		for i := range results {
+			if results[i].Ready {
+				continue
+			}
+
+			if err := runProbe(results[i].Target); err != nil {
+				results[i].LastError = err.Error()
+				pending++
+				continue
+			}
+
+			results[i].Ready = true
+			results[i].LastError = ""
+			fmt.Printf("   ✅ %s is ready (%s)\n", results[i].Service, results[i].Target)
+		}
+
+		if pending == 0 {
+			return results, nil
+		}
+
+		if time.Now().After(deadline) {
+			var failed []string
+			for _, result := range results {
+				if !result.Ready {
+					failed = append(failed, result.Service)
+				}
+			}
+			return results, fmt.Errorf("readiness probes timed out for: %s", strings.Join(failed, ", "))
+		}
+
+		time.Sleep(ProbeInterval)
+	}
+}
+
+// runProbe performs a single readiness check against a probe target
+func runProbe(target string) error {
+	if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+		client := &http.Client{Timeout: ProbeInterval}
+		resp, err := client.Get(target)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= 400 {
+			return fmt.Errorf("HTTP %d", resp.StatusCode)
+		}
+		return nil
+	}
+
+	address := strings.TrimPrefix(target, "tcp://")
+	if !strings.Contains(address, ":") {
+		address = net.JoinHostPort("localhost", address)
+	}
+
+	conn, err := net.DialTimeout("tcp", address, ProbeInterval)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
 // ContainerStatus represents container status information
 type ContainerStatus struct {
 	Name    string
@@ -289,9 +841,10 @@ type ContainerStatus struct {
 	Ports   string
 }
 
-// GetProjectStatus returns the status of all containers in the project
+// GetProjectStatus returns the status of all containers in the project. Remote compose sources
+// are not fetched, so status stays quick and works offline.
 func (cm *ComposeManager) GetProjectStatus(projectDir string) ([]ContainerStatus, error) {
-	project, err := cm.LoadProject(projectDir)
+	project, err := cm.loadLocalProject(projectDir, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -340,18 +893,177 @@ func (cm *ComposeManager) ViewLogs(projectDir string, services []string, follow
 		return err
 	}
 
-	args := []string{"compose", "-f", composeFilePath, "logs"}
+	return cm.executeCommandWithErrorHandling(projectDir, logsArguments(composeFilePath, services, follow)...)
+}
+
+// logsArguments returns the docker compose logs arguments for the given services, all when empty
+func logsArguments(composeFilePath string, services []string, follow bool) []string {
+	args := utils.ComposeArgs(composeFilePath, "logs")
 
 	if follow {
 		args = append(args, "-f")
 	}
 
 	// Add specific services if provided
+	return append(args, services...)
+}
+
+// LogOptions controls how ViewProcessedLogs rewrites streamed log lines
+type LogOptions struct {
+	Follow bool
+	Redact bool // hide the values of secret environment keys
+	Color  bool // color-code service prefixes and align them in a column
+	JSON   bool // wrap JSON log lines with service and timestamp metadata
+	// LocalTime shows timestamps converted to the local time zone
+	LocalTime bool
+}
+
+// ViewProcessedLogs displays logs for the project, processing each line before printing it
+func (cm *ComposeManager) ViewProcessedLogs(projectDir string, services []string, options LogOptions) error {
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	args := utils.ComposeArgs(composeFilePath, "logs")
+	if options.Follow {
+		args = append(args, "-f")
+	}
+	if options.Color || options.JSON {
+		args = append(args, "--no-color")
+	}
+	if options.JSON || options.LocalTime {
+		args = append(args, "--timestamps")
+	}
 	args = append(args, services...)
 
+	var redactor *utils.Redactor
+	if options.Redact {
+		redactor = utils.NewRedactor(ProjectEnvironment(project))
+	}
+	var prefixer *logPrefixer
+	if options.Color {
+		prefixer = newLogPrefixer(project)
+	}
+
+	cmd, ctx, cancel := cm.dockerCommand(projectDir, args...)
+	defer cancel()
+	cmd.Stderr = os.Stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to view logs: %v", err)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if redactor != nil {
+			line = redactor.Redact(line)
+		}
+		if options.LocalTime {
+			line = localizeLogTimestamp(line)
+		}
+		switch {
+		case options.JSON:
+			line = formatJSONLogLine(line)
+		case prefixer != nil:
+			line = prefixer.Format(line)
+		}
+		fmt.Println(line)
+	}
+
+	err = cmd.Wait()
+	if timeoutErr := cm.timeoutError(ctx, args); timeoutErr != nil {
+		return timeoutErr
+	}
+	return err
+}
+
+// ProjectEnvironment returns the environment variables of all services of a project
+func ProjectEnvironment(project *types.Project) map[string]string {
+	env := make(map[string]string)
+	for _, service := range project.Services {
+		for key, value := range service.Environment {
+			if value != nil {
+				env[key] = *value
+			}
+		}
+	}
+	return env
+}
+
+// ViewReplicaLogs displays logs for a single replica of a service
+func (cm *ComposeManager) ViewReplicaLogs(projectDir string, service string, index int, follow bool) error {
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+
+	containerName, err := cm.findReplicaContainer(project.Name, service, index)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"logs"}
+
+	if follow {
+		args = append(args, "-f")
+	}
+
+	args = append(args, containerName)
+
 	return cm.executeCommandWithErrorHandling(projectDir, args...)
 }
 
+// findReplicaContainer returns the name of the Nth replica container of a service
+func (cm *ComposeManager) findReplicaContainer(projectName string, service string, index int) (string, error) {
+	containers, err := cm.GetProjectContainers(projectName)
+	if err != nil {
+		return "", err
+	}
+
+	number := strconv.Itoa(index)
+	replicaCount := 0
+	for _, cont := range containers {
+		if cont.Labels["com.docker.compose.service"] != service || len(cont.Names) == 0 {
+			continue
+		}
+		replicaCount++
+
+		// The container name does not tell the replica apart when container_name is set or
+		// the project name ends with a number, the label always does
+		if cont.Labels["com.docker.compose.container-number"] == number {
+			return strings.TrimPrefix(cont.Names[0], "/"), nil
+		}
+	}
+
+	if replicaCount == 0 {
+		return "", fmt.Errorf("no containers found for service %s", service)
+	}
+
+	return "", fmt.Errorf("replica %d not found for service %s (%d replica(s) available)", index, service, replicaCount)
+}
+
 // PullImages pulls all images for the project
 func (cm *ComposeManager) PullImages(projectDir string) error {
 	// Check Docker health first
@@ -371,7 +1083,7 @@ func (cm *ComposeManager) PullImages(projectDir string) error {
 		return err
 	}
 
-	if err := cm.executeCommandWithErrorHandling(projectDir, "compose", "-f", composeFilePath, "pull"); err != nil {
+	if err := cm.executeCommandWithErrorHandling(projectDir, pullArguments(composeFilePath)...); err != nil {
 		return err
 	}
 
@@ -379,8 +1091,22 @@ func (cm *ComposeManager) PullImages(projectDir string) error {
 	return nil
 }
 
-// BuildImages builds all images for the project
-func (cm *ComposeManager) BuildImages(projectDir string, noBuildCache bool) error {
+// BuildProgressAuto lets compose pick the build output format depending on the terminal
+const BuildProgressAuto = "auto"
+
+// BuildProgressModes are the build output formats accepted by docker compose build --progress
+var BuildProgressModes = []string{BuildProgressAuto, "tty", "plain", "json"}
+
+// ValidateBuildProgress checks that mode is a supported build output format
+func ValidateBuildProgress(mode string) error {
+	if !contains(BuildProgressModes, mode) {
+		return fmt.Errorf("invalid progress mode %q, expected one of: %s", mode, strings.Join(BuildProgressModes, ", "))
+	}
+	return nil
+}
+
+// BuildImages builds all images for the project. progress selects the build output format.
+func (cm *ComposeManager) BuildImages(projectDir string, noBuildCache bool, progress string) error {
 	// Check Docker health first
 	if err := CheckDockerStatus(); err != nil {
 		return err
@@ -398,12 +1124,7 @@ func (cm *ComposeManager) BuildImages(projectDir string, noBuildCache bool) erro
 		return err
 	}
 
-	args := []string{"compose", "-f", composeFilePath, "build"}
-	if noBuildCache {
-		args = append(args, "--no-cache")
-	}
-
-	if err := cm.executeCommandWithErrorHandling(projectDir, args...); err != nil {
+	if err := cm.executeCommandWithErrorHandling(projectDir, buildArguments(composeFilePath, noBuildCache, progress)...); err != nil {
 		return err
 	}
 
@@ -411,19 +1132,44 @@ func (cm *ComposeManager) BuildImages(projectDir string, noBuildCache bool) erro
 	return nil
 }
 
+// pullArguments returns the docker compose pull arguments that pull a project's images
+func pullArguments(composeFilePath string) []string {
+	return utils.ComposeArgs(composeFilePath, "pull")
+}
+
+// buildArguments returns the docker compose build arguments that build a project's images
+func buildArguments(composeFilePath string, noBuildCache bool, progress string) []string {
+	args := utils.ComposeArgs(composeFilePath, "build")
+	if noBuildCache {
+		args = append(args, "--no-cache")
+	}
+	if progress != "" && progress != BuildProgressAuto {
+		args = append(args, "--progress", progress)
+	}
+	return args
+}
+
 // executeCommandWithErrorHandling executes docker commands with enhanced error handling
 func (cm *ComposeManager) executeCommandWithErrorHandling(workingDir string, args ...string) error {
-	cmd := exec.Command("docker", args...)
-	cmd.Dir = workingDir
+	cmd, ctx, cancel := cm.dockerCommand(workingDir, args...)
+	defer cancel()
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
 	err := cmd.Run()
 	if err != nil {
-		// Capture stderr for error analysis
-		cmdForError := exec.Command("docker", args...)
-		cmdForError.Dir = workingDir
+		if timeoutErr := cm.timeoutError(ctx, args); timeoutErr != nil {
+			return timeoutErr
+		}
+
+		// Capture stderr for error analysis, within what is left of the same deadline
+		deadline, _ := ctx.Deadline()
+		cmdForError, ctxForError, cancelForError := cm.dockerCommandUntil(deadline, workingDir, args...)
+		defer cancelForError()
 		errorOutput, _ := cmdForError.CombinedOutput()
+		if timeoutErr := cm.timeoutError(ctxForError, args); timeoutErr != nil {
+			return timeoutErr
+		}
 		errorStr := string(errorOutput)
 
 		// Check for registry authentication errors
@@ -438,7 +1184,7 @@ func (cm *ComposeManager) executeCommandWithErrorHandling(workingDir string, arg
 			if strings.Contains(string(exitError.Stderr), "Cannot connect to the Docker daemon") ||
 				strings.Contains(err.Error(), "connection refused") {
 				fmt.Println()
-				return fmt.Errorf("docker daemon is not running. Please start Docker Desktop and try again")
+				return fmt.Errorf("%w. Please start Docker Desktop and try again", ErrDaemonUnavailable)
 			}
 		}
 
@@ -447,8 +1193,8 @@ func (cm *ComposeManager) executeCommandWithErrorHandling(workingDir string, arg
 			return fmt.Errorf("docker-compose file not found or invalid path")
 		}
 
-		if strings.Contains(errorStr, "network") && strings.Contains(errorStr, "already exists") {
-			fmt.Println("⚠️  Network conflict detected - this usually resolves itself")
+		if network, ok := detectNetworkConflict(errorStr); ok {
+			return &NetworkConflictError{Network: network, Err: err}
 		}
 
 		return err
@@ -499,7 +1245,7 @@ func ExecuteDockerComposeCommand(projectDir string, args ...string) error {
 	case "up":
 		detached := contains(restArgs, "-d") || contains(restArgs, "--detach")
 		removeOrphans := contains(restArgs, "--remove-orphans")
-		return cm.StartProject(projectDir, detached, removeOrphans)
+		return cm.StartProject(projectDir, StartOptions{Detached: detached, RemoveOrphans: removeOrphans})
 
 	case "down":
 		removeVolumes := contains(restArgs, "-v") || contains(restArgs, "--volumes")
@@ -507,7 +1253,7 @@ func ExecuteDockerComposeCommand(projectDir string, args ...string) error {
 		return cm.StopProject(projectDir, removeVolumes, removeImages)
 
 	case "restart":
-		return cm.RestartProject(projectDir)
+		return cm.RestartProject(projectDir, RestartOptions{})
 
 	case "pause":
 		return cm.PauseProject(projectDir)
@@ -520,7 +1266,7 @@ func ExecuteDockerComposeCommand(projectDir string, args ...string) error {
 
 	case "build":
 		noBuildCache := contains(restArgs, "--no-cache")
-		return cm.BuildImages(projectDir, noBuildCache)
+		return cm.BuildImages(projectDir, noBuildCache, BuildProgressAuto)
 
 	case "logs":
 		follow := contains(restArgs, "-f") || contains(restArgs, "--follow")
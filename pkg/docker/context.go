@@ -0,0 +1,107 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/client"
+)
+
+// DockerContext represents an entry of docker's context store
+type DockerContext struct {
+	Name           string `json:"Name"`
+	Description    string `json:"Description"`
+	DockerEndpoint string `json:"DockerEndpoint"`
+	Current        bool   `json:"Current"`
+	Error          string `json:"Error"`
+}
+
+var (
+	contextHostOnce sync.Once
+	contextHost     string
+)
+
+// ListContexts returns the contexts known to the docker CLI
+func ListContexts() ([]DockerContext, error) {
+	output, err := exec.Command(CommandDocker, "context", "ls", "--format", "{{json .}}").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list docker contexts: %v", err)
+	}
+
+	var contexts []DockerContext
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var ctx DockerContext
+		if err := json.Unmarshal([]byte(line), &ctx); err != nil {
+			return nil, fmt.Errorf("failed to parse docker context: %v", err)
+		}
+		contexts = append(contexts, ctx)
+	}
+
+	return contexts, scanner.Err()
+}
+
+// UseContext switches the active docker context
+func UseContext(name string) error {
+	output, err := exec.Command(CommandDocker, "context", "use", name).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to use context %s: %s", name, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// CurrentContext returns the name of the active docker context, or an empty string if unknown
+func CurrentContext() string {
+	if !IsDockerAvailable() {
+		return ""
+	}
+
+	output, err := exec.Command(CommandDocker, "context", "show").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// APIVersion pins the Docker API version used by the SDK instead of negotiating it with the daemon.
+// DOCKER_API_VERSION has the same effect when this is empty.
+var APIVersion string
+
+// dockerClientOptions returns the options used to create Docker SDK clients. The SDK only
+// reads DOCKER_HOST, so the endpoint of the active docker context is used when it is unset.
+func dockerClientOptions() []client.Opt {
+	opts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+	if APIVersion != "" {
+		opts = append(opts, client.WithVersion(APIVersion))
+	}
+
+	if os.Getenv("DOCKER_HOST") != "" {
+		return opts
+	}
+
+	contextHostOnce.Do(func() {
+		if !IsDockerAvailable() {
+			return
+		}
+		output, err := exec.Command(CommandDocker, "context", "inspect", "--format", "{{.Endpoints.docker.Host}}").Output()
+		if err == nil {
+			contextHost = strings.TrimSpace(string(output))
+		}
+	})
+
+	if contextHost != "" {
+		opts = append(opts, client.WithHost(contextHost))
+	}
+	return opts
+}
@@ -0,0 +1,130 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Kinds of issues reported by ValidateProjectsFile
+const (
+	IssueInvalidEntry  = "invalid entry"
+	IssueMissingPath   = "missing path"
+	IssueNoComposeFile = "no compose file"
+	IssueDuplicatePath = "duplicate path"
+)
+
+// ProjectIssue represents a problem found with a projects.json entry
+type ProjectIssue struct {
+	Project string
+	Kind    string
+	Detail  string
+}
+
+// ValidateProjectsFile checks the structure of a projects file and the paths of its entries.
+// Valid entries are loaded into Projects and ProjectsSettings, invalid ones are reported and
+// left out so they disappear on the next save. An error is returned when the file itself
+// cannot be parsed.
+func ValidateProjectsFile(filename string) ([]ProjectIssue, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("%s is not a valid JSON object: %v", filename, err)
+	}
+
+	Projects = make(map[string]string)
+	ProjectsSettings = make(map[string]ProjectSettings)
+
+	var issues []ProjectIssue
+	for projectName, raw := range entries {
+		projectPath, settings, err := parseProjectEntry(raw)
+		if err != nil {
+			issues = append(issues, ProjectIssue{Project: projectName, Kind: IssueInvalidEntry, Detail: err.Error()})
+			continue
+		}
+
+		Projects[projectName] = projectPath
+		if !settings.IsEmpty() {
+			ProjectsSettings[projectName] = settings
+		}
+	}
+
+	issues = append(issues, ValidateProjects()...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Project < issues[j].Project
+	})
+	return issues, nil
+}
+
+// ValidateProjects reports registered projects whose directory or compose file is missing
+// and projects registered more than once under different names
+func ValidateProjects() []ProjectIssue {
+	var issues []ProjectIssue
+	seen := make(map[string]string)
+
+	for _, projectName := range GetSortedProjectNames() {
+		projectPath := Projects[projectName]
+		if utils.IsRemoteSource(projectPath) {
+			continue
+		}
+
+		projectDir, err := utils.ResolveHomeDir(projectPath)
+		if err != nil {
+			issues = append(issues, ProjectIssue{Project: projectName, Kind: IssueMissingPath, Detail: err.Error()})
+			continue
+		}
+
+		if absDir, err := filepath.Abs(projectDir); err == nil {
+			projectDir = absDir
+		}
+
+		if other, ok := seen[projectDir]; ok {
+			issues = append(issues, ProjectIssue{
+				Project: projectName,
+				Kind:    IssueDuplicatePath,
+				Detail:  fmt.Sprintf("same directory as %s (%s)", other, projectDir),
+			})
+		} else {
+			seen[projectDir] = projectName
+		}
+
+		if info, err := os.Stat(projectDir); err != nil || !info.IsDir() {
+			issues = append(issues, ProjectIssue{Project: projectName, Kind: IssueMissingPath, Detail: projectDir})
+			continue
+		}
+
+		if _, err := utils.GetComposeFilePath(projectDir); err != nil {
+			issues = append(issues, ProjectIssue{Project: projectName, Kind: IssueNoComposeFile, Detail: projectDir})
+		}
+	}
+
+	return issues
+}
+
+// RenameProject moves a project and its settings to a new name
+func RenameProject(oldName, newName string) error {
+	projectPath, ok := Projects[oldName]
+	if !ok {
+		return fmt.Errorf("project %s not found", oldName)
+	}
+	if _, exists := Projects[newName]; exists {
+		return fmt.Errorf("project %s already exists", newName)
+	}
+
+	Projects[newName] = projectPath
+	delete(Projects, oldName)
+
+	if settings, ok := ProjectsSettings[oldName]; ok {
+		ProjectsSettings[newName] = settings
+		delete(ProjectsSettings, oldName)
+	}
+	return nil
+}
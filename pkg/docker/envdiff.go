@@ -0,0 +1,244 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/dotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// variableReferencePattern matches $VAR and ${VAR...} references in a compose file. $$ escapes
+// are consumed by the first alternative so they are not reported.
+var variableReferencePattern = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)|\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// VariableReference is a variable interpolated by the compose file
+type VariableReference struct {
+	Name string
+	// Source is ".env" or "environment" when the variable is set, empty when it is missing
+	Source string
+	// HasDefault reports whether the reference provides a fallback, as in ${VAR:-value}
+	HasDefault bool
+	// Suggestion is an unused .env entry with a similar name, a likely typo
+	Suggestion string
+}
+
+// EnvDiff compares the variables referenced by a compose file with the project's .env file
+type EnvDiff struct {
+	Referenced []VariableReference
+	// Unused lists .env entries that the compose file never references
+	Unused []string
+	// EnvFileServices lists the services loading .env through env_file, which use every entry
+	EnvFileServices []string
+}
+
+// Missing returns the referenced variables that are not set and have no default
+func (d EnvDiff) Missing() []VariableReference {
+	var missing []VariableReference
+	for _, ref := range d.Referenced {
+		if ref.Source == "" && !ref.HasDefault {
+			missing = append(missing, ref)
+		}
+	}
+	return missing
+}
+
+// CompareEnvFile scans the raw compose files, included ones too, for variable references and
+// matches them with the .env file of the project and the process environment. When a service
+// loads .env with env_file, its entries are passed to the container and none is unused.
+func CompareEnvFile(projectDir string) (EnvDiff, error) {
+	references, err := ComposeVariables(projectDir)
+	if err != nil {
+		return EnvDiff{}, err
+	}
+
+	envFilePath := filepath.Join(projectDir, ".env")
+	envFile, err := readEnvFile(envFilePath)
+	if err != nil {
+		return EnvDiff{}, err
+	}
+
+	var diff EnvDiff
+	diff.EnvFileServices, err = servicesLoadingEnvFile(projectDir, envFilePath)
+	if err != nil {
+		return EnvDiff{}, err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(references)) {
+		ref := VariableReference{Name: name, HasDefault: references[name]}
+		if _, ok := envFile[name]; ok {
+			ref.Source = ".env"
+		} else if _, ok := os.LookupEnv(name); ok {
+			ref.Source = "environment"
+		}
+		diff.Referenced = append(diff.Referenced, ref)
+	}
+
+	var unreferenced []string
+	for name := range envFile {
+		if _, ok := references[name]; !ok {
+			unreferenced = append(unreferenced, name)
+		}
+	}
+	sort.Strings(unreferenced)
+	if len(diff.EnvFileServices) == 0 {
+		diff.Unused = unreferenced
+	}
+
+	for i, ref := range diff.Referenced {
+		if ref.Source == "" {
+			diff.Referenced[i].Suggestion = closestName(ref.Name, unreferenced)
+		}
+	}
+
+	return diff, nil
+}
+
+// ComposeVariables scans the raw compose files of a project, included ones too, for interpolated
+// variables. The result maps each variable to whether every reference to it provides a default value.
+func ComposeVariables(projectDir string) (map[string]bool, error) {
+	composeFiles, err := utils.GetAllComposeFiles(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	for _, composeFilePath := range composeFiles {
+		data, err := os.ReadFile(composeFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read compose file: %v", err)
+		}
+		content.Write(data)
+		content.WriteString("\n")
+	}
+
+	return composeVariableReferences(content.String()), nil
+}
+
+// rawComposeEnvFiles is the env_file of each service as written in a compose file. It is a
+// path, a list of paths or a list of {path, required} entries.
+type rawComposeEnvFiles struct {
+	Services map[string]struct {
+		EnvFile any `yaml:"env_file"`
+	} `yaml:"services"`
+}
+
+// servicesLoadingEnvFile returns the services whose env_file includes envFilePath, resolving
+// relative paths from the compose file declaring them
+func servicesLoadingEnvFile(projectDir, envFilePath string) ([]string, error) {
+	composeFiles, err := utils.GetAllComposeFiles(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := filepath.Abs(envFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make(map[string]bool)
+	for _, composeFilePath := range composeFiles {
+		content, err := os.ReadFile(composeFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read compose file: %v", err)
+		}
+
+		var raw rawComposeEnvFiles
+		if err := yaml.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse compose file %s: %v", composeFilePath, err)
+		}
+
+		for name, service := range raw.Services {
+			for _, path := range envFilePaths(service.EnvFile) {
+				if !filepath.IsAbs(path) {
+					path = filepath.Join(filepath.Dir(composeFilePath), path)
+				}
+				if abs, err := filepath.Abs(path); err == nil && abs == target {
+					services[name] = true
+				}
+			}
+		}
+	}
+	return slices.Sorted(maps.Keys(services)), nil
+}
+
+// envFilePaths returns the paths of an env_file entry in any of its forms
+func envFilePaths(envFile any) []string {
+	switch value := envFile.(type) {
+	case string:
+		return []string{value}
+	case []any:
+		var paths []string
+		for _, entry := range value {
+			switch item := entry.(type) {
+			case string:
+				paths = append(paths, item)
+			case map[string]any:
+				if path, ok := item["path"].(string); ok {
+					paths = append(paths, path)
+				}
+			}
+		}
+		return paths
+	}
+	return nil
+}
+
+// readEnvFile parses a dotenv file, returning no variables when it does not exist
+func readEnvFile(path string) (map[string]string, error) {
+	if _, err := os.Stat(path); err != nil {
+		return map[string]string{}, nil
+	}
+
+	env, err := dotenv.Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return env, nil
+}
+
+// composeVariableReferences returns the referenced variable names and whether every reference
+// to each of them provides a default value
+func composeVariableReferences(content string) map[string]bool {
+	references := make(map[string]bool)
+
+	for _, match := range variableReferencePattern.FindAllStringSubmatchIndex(content, -1) {
+		var name string
+		hasDefault := false
+		switch {
+		case match[2] >= 0:
+			name = content[match[2]:match[3]]
+			rest := content[match[3]:]
+			hasDefault = strings.HasPrefix(rest, ":-") || strings.HasPrefix(rest, "-")
+		case match[4] >= 0:
+			name = content[match[4]:match[5]]
+		default:
+			continue
+		}
+
+		if seenWithDefault, ok := references[name]; ok {
+			hasDefault = hasDefault && seenWithDefault
+		}
+		references[name] = hasDefault
+	}
+
+	return references
+}
+
+// closestName returns the candidate within a typo-sized edit distance of name, if any
+func closestName(name string, candidates []string) string {
+	best, bestDistance := "", 3
+	for _, candidate := range candidates {
+		if distance := levenshtein(name, candidate); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
@@ -0,0 +1,108 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// EnvExampleFile is the name of the .env template generated next to the compose file
+const EnvExampleFile = ".env.example"
+
+// EnvTemplateCheck compares a project's .env file with its .env.example template
+type EnvTemplateCheck struct {
+	// Missing lists template variables absent from .env that the compose file needs
+	Missing []string
+	// Defaulted lists template variables absent from .env for which the compose file provides a
+	// default, e.g. ${VAR:-x}
+	Defaulted []string
+	// Empty lists variables present in .env without a value
+	Empty []string
+	// Extra lists .env variables the template does not mention
+	Extra []string
+}
+
+// GenerateEnvExample returns a .env.example listing the variables referenced by the compose file
+// with empty values. Variables whose every reference has a default are listed separately.
+func GenerateEnvExample(projectDir string) (string, error) {
+	references, err := ComposeVariables(projectDir)
+	if err != nil {
+		return "", err
+	}
+
+	var required, optional []string
+	for name, hasDefault := range references {
+		if hasDefault {
+			optional = append(optional, name)
+		} else {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(required)
+	sort.Strings(optional)
+
+	var b strings.Builder
+	b.WriteString("# Variables referenced by the compose file. Copy to .env and fill in the values.\n")
+	if len(required) > 0 {
+		b.WriteString("\n# Required\n")
+		for _, name := range required {
+			b.WriteString(name + "=\n")
+		}
+	}
+	if len(optional) > 0 {
+		b.WriteString("\n# Optional, the compose file provides a default\n")
+		for _, name := range optional {
+			b.WriteString(name + "=\n")
+		}
+	}
+
+	return b.String(), nil
+}
+
+// CheckEnvTemplate compares the .env file of a project with its .env.example. Variables the
+// compose file gives a default to are only reported as Defaulted when missing.
+func CheckEnvTemplate(projectDir string) (EnvTemplateCheck, error) {
+	templatePath := filepath.Join(projectDir, EnvExampleFile)
+	if _, err := os.Stat(templatePath); err != nil {
+		return EnvTemplateCheck{}, fmt.Errorf("no %s found in %s", EnvExampleFile, projectDir)
+	}
+
+	template, err := readEnvFile(templatePath)
+	if err != nil {
+		return EnvTemplateCheck{}, err
+	}
+	env, err := readEnvFile(filepath.Join(projectDir, ".env"))
+	if err != nil {
+		return EnvTemplateCheck{}, err
+	}
+	references, err := ComposeVariables(projectDir)
+	if err != nil {
+		return EnvTemplateCheck{}, err
+	}
+
+	var check EnvTemplateCheck
+	for name := range template {
+		value, ok := env[name]
+		switch {
+		case !ok && references[name]:
+			check.Defaulted = append(check.Defaulted, name)
+		case !ok:
+			check.Missing = append(check.Missing, name)
+		case value == "":
+			check.Empty = append(check.Empty, name)
+		}
+	}
+	for name := range env {
+		if _, ok := template[name]; !ok {
+			check.Extra = append(check.Extra, name)
+		}
+	}
+
+	sort.Strings(check.Missing)
+	sort.Strings(check.Defaulted)
+	sort.Strings(check.Empty)
+	sort.Strings(check.Extra)
+	return check, nil
+}
@@ -0,0 +1,127 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/docker/docker/api/types"
+)
+
+// DefaultExecCommand is run when exec is given no command
+var DefaultExecCommand = []string{"sh"}
+
+// ExecOptions configure a command run in a service container
+type ExecOptions struct {
+	Command    []string
+	User       string
+	WorkDir    string
+	DetachKeys string
+}
+
+// ExecResult is the outcome of a command run with ExecInService
+type ExecResult struct {
+	ExitCode int
+	// Detached reports that the session was left with the detach keys while the command kept running
+	Detached bool
+}
+
+// ExecInService runs a command in the first running container of a service. On an interactive
+// terminal the command gets a TTY sized like the terminal that follows its window size changes.
+// Otherwise, or when the daemon refuses to attach, docker compose exec is used instead.
+func (cm *ComposeManager) ExecInService(projectDir, service string, options ExecOptions) (ExecResult, error) {
+	if len(options.Command) == 0 {
+		options.Command = DefaultExecCommand
+	}
+	if options.DetachKeys == "" {
+		options.DetachKeys = DefaultDetachKeys
+	}
+	if err := ValidateDetachKeys(options.DetachKeys); err != nil {
+		return ExecResult{}, err
+	}
+
+	target, err := cm.FindAttachTarget(projectDir, service)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	if !IsInteractiveTerminal() {
+		return cm.execWithCLI(projectDir, service, options, false)
+	}
+
+	size := terminalSize()
+	created, err := cm.dockerClient.ContainerExecCreate(cm.ctx, target.Container, types.ExecConfig{
+		User:         options.User,
+		WorkingDir:   options.WorkDir,
+		Cmd:          options.Command,
+		Tty:          true,
+		ConsoleSize:  size,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		DetachKeys:   options.DetachKeys,
+	})
+	if err != nil {
+		return ExecResult{}, fmt.Errorf("failed to create exec in %s: %v", target.Container, err)
+	}
+
+	stream, err := cm.dockerClient.ContainerExecAttach(cm.ctx, created.ID, types.ExecStartCheck{Tty: true, ConsoleSize: size})
+	if err != nil {
+		return cm.execWithCLI(projectDir, service, options, true)
+	}
+	defer stream.Close()
+
+	err = streamTerminal(stream, func(height, width uint) error {
+		return cm.dockerClient.ContainerExecResize(cm.ctx, created.ID, types.ResizeOptions{Height: height, Width: width})
+	})
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	inspect, err := cm.dockerClient.ContainerExecInspect(cm.ctx, created.ID)
+	if err != nil {
+		return ExecResult{}, fmt.Errorf("failed to inspect exec in %s: %v", target.Container, err)
+	}
+	return ExecResult{ExitCode: inspect.ExitCode, Detached: inspect.Running}, nil
+}
+
+// execWithCLI runs the command with docker compose exec, which has no detach keys
+func (cm *ComposeManager) execWithCLI(projectDir, service string, options ExecOptions, tty bool) (ExecResult, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return ExecResult{}, err
+	}
+
+	cmd := exec.Command(CommandDocker, execArguments(composeFilePath, service, options, tty)...)
+	cmd.Dir = projectDir
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return ExecResult{ExitCode: exitErr.ExitCode()}, nil
+	}
+	if err != nil {
+		return ExecResult{}, fmt.Errorf("failed to run docker compose exec: %v", err)
+	}
+	return ExecResult{}, nil
+}
+
+func execArguments(composeFilePath, service string, options ExecOptions, tty bool) []string {
+	args := utils.ComposeArgs(composeFilePath, "exec")
+
+	if !tty {
+		args = append(args, "-T")
+	}
+	if options.User != "" {
+		args = append(args, "--user", options.User)
+	}
+	if options.WorkDir != "" {
+		args = append(args, "--workdir", options.WorkDir)
+	}
+
+	args = append(args, service)
+	return append(args, options.Command...)
+}
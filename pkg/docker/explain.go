@@ -0,0 +1,78 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ExplainOperations lists the operations ExplainOperation can describe
+var ExplainOperations = []string{"up", "down", "restart", "build", "pull", "logs"}
+
+// shellSafePattern matches arguments that need no quoting in a shell
+var shellSafePattern = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// ExplainOptions are the operation flags to describe. Each operation only uses its own fields.
+type ExplainOptions struct {
+	Start         StartOptions
+	Restart       RestartOptions
+	RemoveVolumes bool
+	RemoveImages  bool
+	NoBuildCache  bool
+	BuildProgress string
+	Follow        bool
+}
+
+// ExplainOperation returns the docker command an operation would run for the project, built
+// by the same functions the operation uses, without running it
+func (cm *ComposeManager) ExplainOperation(projectDir, operation string, options ExplainOptions) ([]string, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var args []string
+	switch operation {
+	case "up", "start":
+		project, err := cm.LoadProject(projectDir)
+		if err != nil {
+			return nil, err
+		}
+		if args, err = upArguments(composeFilePath, project, options.Start); err != nil {
+			return nil, err
+		}
+	case "down", "stop":
+		args = downArguments(composeFilePath, options.RemoveVolumes, options.RemoveImages)
+	case "restart":
+		if args, err = restartArguments(composeFilePath, options.Start.Services, options.Restart); err != nil {
+			return nil, err
+		}
+	case "build":
+		if err := ValidateBuildProgress(options.BuildProgress); err != nil {
+			return nil, err
+		}
+		args = buildArguments(composeFilePath, options.NoBuildCache, options.BuildProgress)
+	case "pull":
+		args = pullArguments(composeFilePath)
+	case "logs":
+		args = logsArguments(composeFilePath, options.Start.Services, options.Follow)
+	default:
+		return nil, fmt.Errorf("unknown operation %s, use one of: %s", operation, strings.Join(ExplainOperations, ", "))
+	}
+
+	return append([]string{CommandDocker}, args...), nil
+}
+
+// ShellQuote joins a command into a string that can be pasted into a POSIX shell
+func ShellQuote(command []string) string {
+	quoted := make([]string, len(command))
+	for i, arg := range command {
+		if shellSafePattern.MatchString(arg) {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
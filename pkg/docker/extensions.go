@@ -0,0 +1,148 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ComposeExtension is an x- extension field of a compose file
+type ComposeExtension struct {
+	// Path locates the field, e.g. "x-common" at the top level or "services.web.x-traefik"
+	Path string
+	Line int
+	// Anchor is the anchor defined on the value, empty when none
+	Anchor string
+	// Value is the field value as written, with anchors and aliases kept
+	Value string
+}
+
+// ComposeAnchor is a YAML anchor of a compose file and the places that reference it
+type ComposeAnchor struct {
+	Name string
+	Path string
+	Line int
+	// Keys are the keys of the anchored mapping, empty for other values
+	Keys []string
+	// UsedBy are the paths of the aliases referencing the anchor, in file order
+	UsedBy []string
+}
+
+// ComposeExtensions holds the x- extension fields and anchors of a compose file
+type ComposeExtensions struct {
+	File       string
+	Extensions []ComposeExtension
+	Anchors    []ComposeAnchor
+}
+
+// ReadComposeExtensions parses the raw compose file of a project and returns its x- extension
+// fields, at the top level and nested in services or other sections, and its anchors with their
+// usages. The loaded project cannot be used since loading resolves aliases and drops x- fields.
+func ReadComposeExtensions(projectDir string) (*ComposeExtensions, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(composeFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compose file: %v", err)
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse compose file: %v", err)
+	}
+
+	extensions := &ComposeExtensions{File: composeFilePath}
+	anchors := make(map[string]*ComposeAnchor)
+	var order []string
+
+	var walk func(node *yaml.Node, path string)
+	walk = func(node *yaml.Node, path string) {
+		if node.Anchor != "" {
+			if _, ok := anchors[node.Anchor]; !ok {
+				order = append(order, node.Anchor)
+			}
+			anchors[node.Anchor] = &ComposeAnchor{Name: node.Anchor, Path: path, Line: node.Line, Keys: mappingKeys(node)}
+		}
+
+		switch node.Kind {
+		case yaml.DocumentNode:
+			for _, child := range node.Content {
+				walk(child, path)
+			}
+		case yaml.AliasNode:
+			// Anchors must be defined before their aliases, so the anchor is already known
+			if anchor, ok := anchors[node.Value]; ok {
+				anchor.UsedBy = append(anchor.UsedBy, path)
+			}
+		case yaml.SequenceNode:
+			for i, child := range node.Content {
+				walk(child, fmt.Sprintf("%s[%d]", path, i))
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key, value := node.Content[i], node.Content[i+1]
+				childPath := extensionPath(path, key.Value)
+				if key.Value == "<<" {
+					// Merged values are used by the mapping itself, also when several are merged
+					merged := []*yaml.Node{value}
+					if value.Kind == yaml.SequenceNode {
+						merged = value.Content
+					}
+					for _, child := range merged {
+						walk(child, path)
+					}
+					continue
+				}
+
+				if strings.HasPrefix(key.Value, "x-") {
+					extensions.Extensions = append(extensions.Extensions, ComposeExtension{
+						Path:   childPath,
+						Line:   key.Line,
+						Anchor: value.Anchor,
+						Value:  renderExtensionValue(value),
+					})
+				}
+				walk(value, childPath)
+			}
+		}
+	}
+	walk(&document, "")
+
+	for _, name := range order {
+		extensions.Anchors = append(extensions.Anchors, *anchors[name])
+	}
+	return extensions, nil
+}
+
+// extensionPath appends a mapping key to a dotted path
+func extensionPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// renderExtensionValue renders a YAML value as written in the compose file
+func renderExtensionValue(node *yaml.Node) string {
+	if node.Kind == yaml.AliasNode {
+		return "*" + node.Value
+	}
+
+	// Render without the anchor, which is reported separately
+	value := *node
+	value.Anchor = ""
+
+	var rendered strings.Builder
+	encoder := yaml.NewEncoder(&rendered)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&value); err != nil {
+		return fmt.Sprintf("(cannot render: %v)", err)
+	}
+	encoder.Close()
+	return strings.TrimRight(rendered.String(), "\n")
+}
@@ -4,9 +4,12 @@ import (
 	"dockyard/pkg/utils"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 
 	"github.com/AlecAivazis/survey/v2"
 )
@@ -17,28 +20,111 @@ func LoadProjectsFromFile(filename string) error {
 		return err
 	}
 
-	err = json.Unmarshal(data, &Projects)
+	var entries map[string]json.RawMessage
+	err = json.Unmarshal(data, &entries)
 	if err != nil {
 		return err
 	}
 
+	for projectName, raw := range entries {
+		projectPath, settings, err := parseProjectEntry(raw)
+		if err != nil {
+			return fmt.Errorf("invalid entry for project %s: %v", projectName, err)
+		}
+
+		Projects[projectName] = projectPath
+		if settings.IsEmpty() {
+			delete(ProjectsSettings, projectName)
+		} else {
+			ProjectsSettings[projectName] = settings
+		}
+	}
+
 	return nil
 }
 
+// parseProjectEntry decodes a projects.json entry, which is either a plain path or an
+// object holding the path alongside optional settings
+func parseProjectEntry(raw json.RawMessage) (string, ProjectSettings, error) {
+	var projectPath string
+	if err := json.Unmarshal(raw, &projectPath); err == nil {
+		return projectPath, ProjectSettings{}, nil
+	}
+
+	var entry projectEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return "", ProjectSettings{}, err
+	}
+	if entry.Path == "" {
+		return "", ProjectSettings{}, fmt.Errorf("missing path")
+	}
+	return entry.Path, entry.ProjectSettings, nil
+}
+
 func SaveProjectsToFile(filename string) error {
-	data, err := json.Marshal(Projects)
+	entries := make(map[string]interface{}, len(Projects))
+	for projectName, projectPath := range Projects {
+		settings, ok := ProjectsSettings[projectName]
+		if !ok || settings.IsEmpty() {
+			entries[projectName] = projectPath
+			continue
+		}
+		entries[projectName] = projectEntry{Path: projectPath, ProjectSettings: settings}
+	}
+
+	data, err := json.Marshal(entries)
 	if err != nil {
 		return err
 	}
 
-	err = os.WriteFile(filename, data, os.ModePerm)
+	// Write to a temporary file first so an interrupted save never truncates the config
+	tmp, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
 	if err != nil {
 		return err
 	}
+	defer os.Remove(tmp.Name())
 
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// Keep the permissions of the existing file
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(filename); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filename)
+}
+
+// ReloadProjectsFromFile replaces the loaded projects with the contents of the file, so
+// projects removed from it disappear as well
+func ReloadProjectsFromFile(filename string) error {
+	previousProjects, previousSettings := Projects, ProjectsSettings
+	Projects = make(map[string]string)
+	ProjectsSettings = make(map[string]ProjectSettings)
+
+	if err := LoadProjectsFromFile(filename); err != nil {
+		Projects, ProjectsSettings = previousProjects, previousSettings
+		return err
+	}
 	return nil
 }
 
+// NotifyProjectsReload relays SIGHUP to the returned channel so long-running commands can
+// reload projects.json from their own loop. Call the returned function to stop relaying.
+func NotifyProjectsReload() (<-chan os.Signal, func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	return signals, func() { signal.Stop(signals) }
+}
+
 func CheckAndLoadProjectsFile(filePath string) error {
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
 		var createFile string
@@ -176,3 +262,63 @@ func GetDockerFilesInfo(dirPath string) string {
 
 	return strings.Join(info, ", ")
 }
+
+// CopyDirectory recursively copies the contents of srcDir into dstDir, which must not exist yet
+func CopyDirectory(srcDir, dstDir string) error {
+	if _, err := os.Stat(dstDir); err == nil {
+		return fmt.Errorf("destination already exists: %s", dstDir)
+	}
+
+	return filepath.WalkDir(srcDir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dstDir, relPath)
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case entry.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		case entry.Type()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case entry.Type().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		default:
+			// Skip sockets, devices and other special files
+			return nil
+		}
+	})
+}
+
+// copyFile copies a single regular file preserving its permissions
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
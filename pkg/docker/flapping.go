@@ -0,0 +1,115 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultFlapWindow is the window recent restarts are counted in
+	DefaultFlapWindow = 10 * time.Minute
+	// DefaultFlapThreshold is the number of restarts within the window that makes a container flap
+	DefaultFlapThreshold = 3
+)
+
+// ContainerRestarts is the restart history of a container
+type ContainerRestarts struct {
+	Name    string
+	Service string
+	State   string
+	// RestartCount is the number of restarts done by the restart policy since the container was created
+	RestartCount int
+	// RecentDeaths is the number of times the container died on its own within the window
+	RecentDeaths int
+	// LastExit describes why the container last stopped, empty when it never did
+	LastExit string
+	Flapping bool
+}
+
+// ContainerRestartHistory returns the restart history of every container of a compose project,
+// flapping containers first. A container flaps when it died at least threshold times within the
+// window, according to the events of the daemon, without being stopped or killed on purpose.
+func (cm *ComposeManager) ContainerRestartHistory(composeProject string, window time.Duration, threshold int) ([]ContainerRestarts, error) {
+	containers, err := cm.GetProjectContainers(composeProject)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := cm.projectEvents(composeProject, time.Now().Add(-window))
+	if err != nil {
+		return nil, err
+	}
+	deaths := make(map[string]int)
+	stopping := make(map[string]bool)
+	for _, message := range messages {
+		id := message.Actor.ID
+		switch message.Action {
+		case "kill":
+			// Signals such as SIGHUP ask for a reload and are not followed by a die
+			stopping[id] = stopping[id] || stopSignal(message.Actor.Attributes["signal"])
+		case "stop":
+			stopping[id] = true
+		case "start":
+			stopping[id] = false
+		case "die":
+			// A die that follows a stop, a restart or a kill was asked for, not a crash
+			if !stopping[id] {
+				deaths[id]++
+			}
+			stopping[id] = false
+		}
+	}
+
+	var history []ContainerRestarts
+	for _, cont := range containers {
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect container %s: %v", ShortID(cont.ID), err)
+		}
+
+		restarts := ContainerRestarts{
+			Name:         strings.TrimPrefix(inspect.Name, "/"),
+			Service:      cont.Labels[LabelComposeService],
+			State:        cont.State,
+			RestartCount: inspect.RestartCount,
+			RecentDeaths: deaths[cont.ID],
+		}
+		if state := inspect.State; state != nil {
+			switch {
+			case state.OOMKilled:
+				restarts.LastExit = fmt.Sprintf("OOM killed (exit code %d)", state.ExitCode)
+			case state.Error != "":
+				restarts.LastExit = state.Error
+			case state.FinishedAt != "" && !strings.HasPrefix(state.FinishedAt, "0001-"):
+				restarts.LastExit = fmt.Sprintf("exit code %d", state.ExitCode)
+			}
+		}
+		restarts.Flapping = restarts.RecentDeaths >= threshold
+		history = append(history, restarts)
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		a, b := history[i], history[j]
+		switch {
+		case a.Flapping != b.Flapping:
+			return a.Flapping
+		case a.RecentDeaths != b.RecentDeaths:
+			return a.RecentDeaths > b.RecentDeaths
+		case a.RestartCount != b.RestartCount:
+			return a.RestartCount > b.RestartCount
+		}
+		return a.Name < b.Name
+	})
+	return history, nil
+}
+
+// stopSignal reports whether a kill event signal, as a number or a name, terminates the container
+func stopSignal(signal string) bool {
+	switch strings.TrimPrefix(strings.ToUpper(signal), "SIG") {
+	case "9", "15", "KILL", "TERM", "INT", "2", "QUIT", "3":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package docker
+
+import (
+	"bufio"
+	"context"
+	"dockyard/pkg/utils"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// LogTailLines is the number of log lines reported when a service fails to become healthy
+const LogTailLines = 20
+
+// UpServices creates and starts specific services in the background
+func (cm *ComposeManager) UpServices(projectDir string, services []string) error {
+	fmt.Printf("🚀 Starting services: %s\n", strings.Join(services, ", "))
+	return cm.executeServiceCommand(projectDir, []string{"up", "-d"}, services)
+}
+
+// FollowServiceUntilHealthy starts a service and streams its logs until its healthcheck
+// passes, fails or the timeout expires. On failure the last log lines are part of the error.
+func (cm *ComposeManager) FollowServiceUntilHealthy(projectDir, service string, timeout time.Duration) error {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+
+	if _, err := project.GetService(service); err != nil {
+		return fmt.Errorf("service %s not found in project %s", service, project.Name)
+	}
+
+	if err := cm.UpServices(projectDir, []string{service}); err != nil {
+		return err
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(cm.ctx)
+	defer cancel()
+
+	reader, writer := io.Pipe()
+	cmd := exec.CommandContext(ctx, CommandDocker, utils.ComposeArgs(composeFilePath, "logs", "-f", "--no-log-prefix", service)...)
+	cmd.Dir = projectDir
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to follow logs: %v", err)
+	}
+
+	tail := newLogTail(LogTailLines)
+	streamDone := make(chan struct{})
+	go func() {
+		defer close(streamDone)
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			line := scanner.Text()
+			fmt.Println(line)
+			tail.add(line)
+		}
+	}()
+
+	fmt.Printf("📋 Following logs of %s until it is healthy (timeout %s)...\n", service, timeout)
+	healthErr := cm.waitForServiceHealth(ctx, project.Name, service, timeout)
+
+	// Stop the log stream and let the reader drain what was already written
+	cancel()
+	cmd.Wait()
+	writer.Close()
+	<-streamDone
+
+	if healthErr != nil {
+		return fmt.Errorf("%v\nlast %d log lines of %s:\n%s", healthErr, LogTailLines, service, tail.String())
+	}
+
+	fmt.Printf("✅ Service %s is healthy\n", service)
+	return nil
+}
+
+// waitForServiceHealth polls the healthcheck status of a service until it is healthy
+func (cm *ComposeManager) waitForServiceHealth(ctx context.Context, projectName, service string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := cm.serviceHealthStatus(ctx, projectName, service)
+		if err != nil {
+			return err
+		}
+
+		switch status {
+		case "healthy":
+			return nil
+		case "unhealthy":
+			return fmt.Errorf("service %s is unhealthy", service)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("service %s did not become healthy within %s", service, timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(ProbeInterval):
+		}
+	}
+}
+
+// serviceHealthStatus returns the healthcheck status of the first container of a service
+func (cm *ComposeManager) serviceHealthStatus(ctx context.Context, projectName, service string) (string, error) {
+	containers, err := cm.GetProjectContainers(projectName)
+	if err != nil {
+		return "", err
+	}
+
+	for _, cont := range containers {
+		if cont.Labels["com.docker.compose.service"] != service {
+			continue
+		}
+
+		inspect, err := cm.dockerClient.ContainerInspect(ctx, cont.ID)
+		if err != nil {
+			return "", fmt.Errorf("failed to inspect container: %v", err)
+		}
+
+		if inspect.State == nil {
+			return "", nil
+		}
+		if !inspect.State.Running && !inspect.State.Restarting {
+			return "", fmt.Errorf("service %s stopped (exit code %d)", service, inspect.State.ExitCode)
+		}
+		if inspect.State.Health == nil {
+			return "", fmt.Errorf("service %s has no healthcheck defined", service)
+		}
+		return inspect.State.Health.Status, nil
+	}
+
+	// The container may not be listed yet right after `up`
+	return "", nil
+}
+
+// logTail keeps the last lines written to it
+type logTail struct {
+	lines []string
+	size  int
+}
+
+func newLogTail(size int) *logTail {
+	return &logTail{size: size}
+}
+
+func (t *logTail) add(line string) {
+	t.lines = append(t.lines, line)
+	if len(t.lines) > t.size {
+		t.lines = t.lines[len(t.lines)-t.size:]
+	}
+}
+
+func (t *logTail) String() string {
+	return strings.Join(t.lines, "\n")
+}
@@ -0,0 +1,129 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+
+	dockertypes "github.com/docker/docker/api/types"
+)
+
+// ProjectFootprint is the resource usage of the running containers of one project
+type ProjectFootprint struct {
+	Project     string // registered project name, or the compose project name when not registered
+	Registered  bool
+	Containers  int
+	CPUPercent  float64 // percentage of a single core
+	MemoryUsage int64
+	ImageSize   int64 // images used by the running containers
+	VolumeSize  int64 // volumes created by compose for the project
+}
+
+// DiskUsage returns the disk space used by the project's images and volumes
+func (pf ProjectFootprint) DiskUsage() int64 {
+	return pf.ImageSize + pf.VolumeSize
+}
+
+// Footprint is the resource usage of all running compose projects, heaviest project first
+type Footprint struct {
+	Projects    []ProjectFootprint
+	CPUPercent  float64
+	MemoryUsage int64
+	// ImageSize counts images shared by several projects once
+	ImageSize  int64
+	VolumeSize int64
+	MemTotal   int64
+	NCPU       int
+}
+
+// ComputeFootprint sums the CPU and memory usage of every running compose container per project,
+// along with the disk used by their images and volumes
+func (cm *ComposeManager) ComputeFootprint() (*Footprint, error) {
+	if err := cm.ensureDockerRunning(); err != nil {
+		return nil, fmt.Errorf("docker is not accessible: %v", err)
+	}
+
+	// Computing volume sizes can take a while, so it runs while the containers are sampled
+	type diskUsageResult struct {
+		usage dockertypes.DiskUsage
+		err   error
+	}
+	diskUsageCh := make(chan diskUsageResult, 1)
+	go func() {
+		usage, err := cm.dockerClient.DiskUsage(cm.ctx, dockertypes.DiskUsageOptions{
+			Types: []dockertypes.DiskUsageObject{dockertypes.ImageObject, dockertypes.VolumeObject},
+		})
+		diskUsageCh <- diskUsageResult{usage, err}
+	}()
+
+	containers, usages, err := cm.sampleComposeContainers()
+	if err != nil {
+		return nil, err
+	}
+	info, err := cm.dockerClient.Info(cm.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system information: %v", err)
+	}
+	diskUsage := <-diskUsageCh
+	if diskUsage.err != nil {
+		return nil, fmt.Errorf("failed to get disk usage: %v", diskUsage.err)
+	}
+
+	imageSizes := make(map[string]int64)
+	for _, image := range diskUsage.usage.Images {
+		imageSizes[image.ID] = image.Size
+	}
+
+	footprint := &Footprint{MemTotal: info.MemTotal, NCPU: info.NCPU}
+	projectsByDir := registeredProjectDirs()
+	byProject := make(map[string]*ProjectFootprint)
+	byComposeProject := make(map[string]*ProjectFootprint)
+	projectImages := make(map[*ProjectFootprint]map[string]bool)
+	allImages := make(map[string]bool)
+
+	for i, cont := range containers {
+		name, registered := containerProject(cont, projectsByDir)
+		project, ok := byProject[name]
+		if !ok {
+			project = &ProjectFootprint{Project: name, Registered: registered}
+			byProject[name] = project
+			projectImages[project] = make(map[string]bool)
+		}
+		byComposeProject[cont.Labels[LabelComposeProject]] = project
+
+		project.Containers++
+		project.CPUPercent += usages[i].CPUPercent
+		project.MemoryUsage += usages[i].MemoryUsage
+		footprint.CPUPercent += usages[i].CPUPercent
+		footprint.MemoryUsage += usages[i].MemoryUsage
+
+		if !projectImages[project][cont.ImageID] {
+			projectImages[project][cont.ImageID] = true
+			project.ImageSize += imageSizes[cont.ImageID]
+		}
+		if !allImages[cont.ImageID] {
+			allImages[cont.ImageID] = true
+			footprint.ImageSize += imageSizes[cont.ImageID]
+		}
+	}
+
+	for _, volume := range diskUsage.usage.Volumes {
+		project, ok := byComposeProject[volume.Labels[LabelComposeProject]]
+		if !ok || volume.UsageData == nil || volume.UsageData.Size < 0 {
+			continue
+		}
+		project.VolumeSize += volume.UsageData.Size
+		footprint.VolumeSize += volume.UsageData.Size
+	}
+
+	for _, project := range byProject {
+		footprint.Projects = append(footprint.Projects, *project)
+	}
+	sort.Slice(footprint.Projects, func(i, j int) bool {
+		a, b := footprint.Projects[i], footprint.Projects[j]
+		if a.MemoryUsage != b.MemoryUsage {
+			return a.MemoryUsage > b.MemoryUsage
+		}
+		return a.Project < b.Project
+	})
+	return footprint, nil
+}
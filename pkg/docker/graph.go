@@ -0,0 +1,141 @@
+package docker
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// Topology graph output formats
+const (
+	GraphFormatDot     = "dot"
+	GraphFormatMermaid = "mermaid"
+)
+
+// GraphFormats lists the formats accepted by RenderTopology
+var GraphFormats = []string{GraphFormatDot, GraphFormatMermaid}
+
+// mermaidIDPattern matches the characters Mermaid does not accept in node identifiers
+var mermaidIDPattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// topologyNode is a service with the attributes drawn in the graph
+type topologyNode struct {
+	Service   string
+	Ports     []string
+	Networks  []string
+	DependsOn []string
+}
+
+// RenderTopology describes the services of a project, the networks they join and their
+// depends_on edges as a Graphviz DOT or Mermaid graph. Published ports annotate service nodes.
+func RenderTopology(project *types.Project, format string) (string, error) {
+	nodes, networks := topology(project)
+
+	switch format {
+	case GraphFormatDot:
+		return renderDot(project.Name, nodes, networks), nil
+	case GraphFormatMermaid:
+		return renderMermaid(nodes, networks), nil
+	default:
+		return "", fmt.Errorf("unknown graph format %s, use one of: %s", format, strings.Join(GraphFormats, ", "))
+	}
+}
+
+// topology collects the sorted nodes and networks of a project
+func topology(project *types.Project) ([]topologyNode, []string) {
+	networkSet := make(map[string]bool)
+	var nodes []topologyNode
+
+	for _, service := range project.Services {
+		node := topologyNode{Service: service.Name}
+		for _, port := range service.Ports {
+			if port.Published == "" {
+				continue
+			}
+			node.Ports = append(node.Ports, fmt.Sprintf("%s:%d/%s", port.Published, port.Target, port.Protocol))
+		}
+		for network := range serviceNetworks(service) {
+			node.Networks = append(node.Networks, network)
+			networkSet[network] = true
+		}
+		for dependency := range service.DependsOn {
+			node.DependsOn = append(node.DependsOn, dependency)
+		}
+		sort.Strings(node.Networks)
+		sort.Strings(node.DependsOn)
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Service < nodes[j].Service })
+	return nodes, slices.Sorted(maps.Keys(networkSet))
+}
+
+// renderDot renders the topology as a Graphviz digraph
+func renderDot(projectName string, nodes []topologyNode, networks []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", projectName)
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n\n")
+
+	for _, network := range networks {
+		fmt.Fprintf(&b, "  %q [shape=ellipse, style=dashed, label=%q];\n", "network:"+network, network)
+	}
+	for _, node := range nodes {
+		label := node.Service
+		if len(node.Ports) > 0 {
+			label += "\\n" + strings.Join(node.Ports, "\\n")
+		}
+		fmt.Fprintf(&b, "  %q [label=\"%s\"];\n", node.Service, strings.ReplaceAll(label, `"`, `\"`))
+	}
+	b.WriteString("\n")
+
+	for _, node := range nodes {
+		for _, dependency := range node.DependsOn {
+			fmt.Fprintf(&b, "  %q -> %q;\n", node.Service, dependency)
+		}
+		for _, network := range node.Networks {
+			fmt.Fprintf(&b, "  %q -> %q [style=dotted, arrowhead=none];\n", node.Service, "network:"+network)
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// renderMermaid renders the topology as a Mermaid flowchart
+func renderMermaid(nodes []topologyNode, networks []string) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	for _, network := range networks {
+		fmt.Fprintf(&b, "  net_%s([%s])\n", mermaidID(network), network)
+	}
+	for _, node := range nodes {
+		label := node.Service
+		if len(node.Ports) > 0 {
+			label += "<br/>" + strings.Join(node.Ports, "<br/>")
+		}
+		fmt.Fprintf(&b, "  svc_%s[\"%s\"]\n", mermaidID(node.Service), label)
+	}
+
+	for _, node := range nodes {
+		for _, dependency := range node.DependsOn {
+			fmt.Fprintf(&b, "  svc_%s --> svc_%s\n", mermaidID(node.Service), mermaidID(dependency))
+		}
+		for _, network := range node.Networks {
+			fmt.Fprintf(&b, "  svc_%s -.- net_%s\n", mermaidID(node.Service), mermaidID(network))
+		}
+	}
+
+	return b.String()
+}
+
+// mermaidID turns a name into a valid Mermaid node identifier
+func mermaidID(name string) string {
+	return mermaidIDPattern.ReplaceAllString(name, "_")
+}
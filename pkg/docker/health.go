@@ -2,7 +2,9 @@ package docker
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
 	"strings"
@@ -10,6 +12,7 @@ import (
 
 	"dockyard/pkg/ui"
 	"github.com/AlecAivazis/survey/v2"
+	dockertypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
 )
 
@@ -37,6 +40,12 @@ const (
 	CommandPodman = "podman"
 )
 
+// ErrDaemonUnavailable indicates that the Docker daemon could not be reached
+var ErrDaemonUnavailable = errors.New("docker daemon is not running")
+
+// ErrAPIVersionMismatch indicates that the daemon does not support the API version of the SDK
+var ErrAPIVersionMismatch = errors.New("incompatible Docker API version")
+
 type ContainerRuntimeError struct {
 	Runtime ContainerRuntime
 	Err     error
@@ -65,7 +74,7 @@ type HealthChecker struct {
 
 func NewDockerHealthChecker() (*HealthChecker, error) {
 	ctx := context.Background()
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	cli, err := client.NewClientWithOpts(dockerClientOptions()...)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +130,7 @@ func checkDockerStatusBrief() error {
 	if !IsDockerAvailable() {
 		fmt.Print(" ❌")
 		fmt.Println()
-		return handleDockerNotInstalled()
+		return fmt.Errorf("%w: %v", ErrDaemonUnavailable, handleDockerNotInstalled())
 	}
 
 	// Quick daemon connectivity check
@@ -141,7 +150,10 @@ func checkDockerStatusBrief() error {
 	if err != nil {
 		fmt.Print(" ❌")
 		fmt.Println()
-		return handleDockerDaemonError(err)
+		if err := handleDockerDaemonError(err); err != nil {
+			return fmt.Errorf("%w: %v", ErrDaemonUnavailable, err)
+		}
+		return nil
 	}
 
 	// Success - show clean checkmark
@@ -152,8 +164,16 @@ func checkDockerStatusBrief() error {
 	return offerDetailedDockerInfo()
 }
 
+// Unattended skips the offer to show detailed Docker status after a successful check, for
+// commands that keep running without anyone at the terminal
+var Unattended bool
+
 // offerDetailedDockerInfo asks the user if they want to see detailed Docker status
 func offerDetailedDockerInfo() error {
+	if Unattended {
+		return nil
+	}
+
 	var showDetails bool
 	prompt := &survey.Confirm{
 		Message: "Show detailed Docker status?",
@@ -193,36 +213,102 @@ func showDetailedDockerStatus() error {
 	ctx, cancel := context.WithTimeout(dhc.ctx, PingTimeout)
 	defer cancel()
 
-	// Get Docker version info
+	printServerVersion(ctx, dhc)
+	printSystemInfo(ctx, dhc)
+
+	fmt.Println(ui.RenderSuccess("Docker is running properly! 🚀"))
+	return nil
+}
+
+// ShowDaemonInfo prints the daemon version, system information, enabled features and the
+// warnings reported by the daemon
+func ShowDaemonInfo() error {
+	dhc, err := NewDockerHealthChecker()
+	if err != nil {
+		return fmt.Errorf("failed to create Docker client: %v", err)
+	}
+	defer dhc.Close()
+
+	if err := dhc.CheckDockerDaemon(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(dhc.ctx, PingTimeout)
+	defer cancel()
+
+	printServerVersion(ctx, dhc)
+	info, ok := printSystemInfo(ctx, dhc)
+	if !ok {
+		return nil
+	}
+
+	fmt.Println(ui.RenderHeader("🧩 Features"))
+	ping, err := dhc.client.Ping(ctx)
+	buildKit := err == nil && ping.BuilderVersion == dockertypes.BuilderBuildKit
+	if os.Getenv("DOCKER_BUILDKIT") == "0" {
+		buildKit = false
+	}
+	fmt.Printf("   BuildKit: %s\n", enabledLabel(buildKit))
+	fmt.Printf("   Buildx plugin: %s\n", enabledLabel(exec.Command(CommandDocker, "buildx", "version").Run() == nil))
+	fmt.Printf("   Experimental: %s\n", enabledLabel(info.ExperimentalBuild))
+	fmt.Printf("   Live Restore: %s\n", enabledLabel(info.LiveRestoreEnabled))
+	if info.CgroupVersion != "" {
+		fmt.Printf("   Cgroup: %s v%s\n", info.CgroupDriver, info.CgroupVersion)
+	}
+	fmt.Println()
+
+	if len(info.Warnings) == 0 {
+		fmt.Println(ui.RenderSuccess("The daemon reports no warnings"))
+		return nil
+	}
+
+	fmt.Println(ui.RenderHeader(fmt.Sprintf("⚠️  Daemon Warnings (%d)", len(info.Warnings))))
+	for _, warning := range info.Warnings {
+		fmt.Printf("   • %s\n", warning)
+	}
+	return nil
+}
+
+// printServerVersion prints the engine version of the daemon
+func printServerVersion(ctx context.Context, dhc *HealthChecker) {
 	version, err := dhc.client.ServerVersion(ctx)
-	if err == nil {
-		fmt.Printf("🐳 %s\n", ui.RenderSuccess(fmt.Sprintf("Docker Engine %s", version.Version)))
-		fmt.Printf("   API Version: %s\n", version.APIVersion)
-		fmt.Printf("   Platform: %s/%s\n", version.Os, version.Arch)
-		fmt.Println()
-	} else {
+	if err != nil {
 		fmt.Println(ui.RenderWarning("Could not retrieve Docker version info"))
+		return
 	}
 
-	// Get system info
+	fmt.Printf("🐳 %s\n", ui.RenderSuccess(fmt.Sprintf("Docker Engine %s", version.Version)))
+	fmt.Printf("   API Version: %s\n", version.APIVersion)
+	fmt.Printf("   Platform: %s/%s\n", version.Os, version.Arch)
+	fmt.Println()
+}
+
+// printSystemInfo prints the system information of the daemon and returns it
+func printSystemInfo(ctx context.Context, dhc *HealthChecker) (dockertypes.Info, bool) {
 	info, err := dhc.client.Info(ctx)
-	if err == nil {
-		fmt.Println(ui.RenderHeader("🔧 System Information"))
-		fmt.Printf("   Containers: %d (running: %d, paused: %d, stopped: %d)\n",
-			info.Containers, info.ContainersRunning, info.ContainersPaused, info.ContainersStopped)
-		fmt.Printf("   Images: %d\n", info.Images)
-		fmt.Printf("   Server Version: %s\n", info.ServerVersion)
-		fmt.Printf("   Storage Driver: %s\n", info.Driver)
-		fmt.Printf("   Total Memory: %.2f GB\n", float64(info.MemTotal)/(1024*1024*1024))
-		fmt.Printf("   CPUs: %d\n", info.NCPU)
-		fmt.Println()
-	} else {
+	if err != nil {
 		fmt.Println(ui.RenderWarning("Could not retrieve system information"))
 		fmt.Println()
+		return info, false
 	}
 
-	fmt.Println(ui.RenderSuccess("Docker is running properly! 🚀"))
-	return nil
+	fmt.Println(ui.RenderHeader("🔧 System Information"))
+	fmt.Printf("   Containers: %d (running: %d, paused: %d, stopped: %d)\n",
+		info.Containers, info.ContainersRunning, info.ContainersPaused, info.ContainersStopped)
+	fmt.Printf("   Images: %d\n", info.Images)
+	fmt.Printf("   Server Version: %s\n", info.ServerVersion)
+	fmt.Printf("   Storage Driver: %s\n", info.Driver)
+	fmt.Printf("   Total Memory: %.2f GB\n", float64(info.MemTotal)/(1024*1024*1024))
+	fmt.Printf("   CPUs: %d\n", info.NCPU)
+	fmt.Println()
+	return info, true
+}
+
+func enabledLabel(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
 }
 
 func handleDockerNotInstalled() error {
@@ -0,0 +1,61 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HealthNone is the status of a container that defines no healthcheck
+const HealthNone = "none"
+
+// HealthSample is the healthcheck state of a container at one point in time
+type HealthSample struct {
+	Container     string
+	Service       string
+	Status        string // starting, healthy, unhealthy, none, or the container state when not running
+	FailingStreak int
+	// Output and ExitCode come from the latest healthcheck probe
+	Output   string
+	ExitCode int
+}
+
+// SampleHealth inspects the healthcheck state of every container of a project, sorted by container name
+func (cm *ComposeManager) SampleHealth(projectName string) ([]HealthSample, error) {
+	containers, err := cm.GetProjectContainers(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []HealthSample
+	for _, cont := range containers {
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect container %s: %v", ShortID(cont.ID), err)
+		}
+
+		sample := HealthSample{
+			Container: strings.TrimPrefix(inspect.Name, "/"),
+			Service:   cont.Labels[LabelComposeService],
+			Status:    HealthNone,
+		}
+		switch {
+		case inspect.State == nil:
+		case inspect.State.Health != nil && inspect.State.Running:
+			health := inspect.State.Health
+			sample.Status = health.Status
+			sample.FailingStreak = health.FailingStreak
+			if len(health.Log) > 0 {
+				last := health.Log[len(health.Log)-1]
+				sample.Output = strings.TrimSpace(last.Output)
+				sample.ExitCode = last.ExitCode
+			}
+		case !inspect.State.Running:
+			sample.Status = inspect.State.Status
+		}
+		samples = append(samples, sample)
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Container < samples[j].Container })
+	return samples, nil
+}
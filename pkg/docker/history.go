@@ -0,0 +1,127 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"dockyard/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// maxHistoryEntries bounds the operation history, older entries are dropped
+const maxHistoryEntries = 5000
+
+// HistoryEntry is an operation dockyard ran on a project
+type HistoryEntry struct {
+	Time    time.Time `json:"time"`
+	Project string    `json:"project"`
+	Action  string    `json:"action"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// historyPath returns the file holding the operation history, one JSON entry per line
+func historyPath() (string, error) {
+	return utils.ConfigDir("history.jsonl")
+}
+
+// RecordOperation appends an operation and its outcome to the history
+func RecordOperation(projectName, action string, opErr error) error {
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+
+	entry := HistoryEntry{Time: time.Now(), Project: projectName, Action: action}
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %v", err)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open history: %v", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to record operation: %v", err)
+	}
+	return trimHistory(path)
+}
+
+// trimHistory drops the oldest entries once the history holds more than maxHistoryEntries.
+// The trimmed history replaces the file in one rename, so readers and an interrupted trim
+// never see it truncated.
+func trimHistory(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if len(lines) <= maxHistoryEntries+1 {
+		return nil
+	}
+	kept := bytes.Join(lines[len(lines)-maxHistoryEntries-1:], nil)
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(kept); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ProjectHistory returns the operations run on a project since the given time, oldest first
+func ProjectHistory(projectName string, since time.Time) ([]HistoryEntry, error) {
+	path, err := historyPath()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %v", err)
+	}
+	defer file.Close()
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry HistoryEntry
+		// Skip lines that were cut short by an interrupted write
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if entry.Project == projectName && !entry.Time.Before(since) {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %v", err)
+	}
+	return entries, nil
+}
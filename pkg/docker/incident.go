@@ -0,0 +1,87 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultIncidentWindow is the width of the window of logs centered on an incident
+const DefaultIncidentWindow = 5 * time.Minute
+
+// IncidentLine is a log line of a service with the time it was written
+type IncidentLine struct {
+	Time      time.Time
+	Service   string
+	Container string
+	Message   string
+}
+
+// IncidentLogs holds the logs of every service of a project around an incident
+type IncidentLogs struct {
+	Since time.Time
+	Until time.Time
+	Lines []IncidentLine
+	// Failed maps the services whose logs could not be read to the error
+	Failed map[string]string
+}
+
+// ProjectIncidentLogs reads the logs each service of a project wrote within the window centered on
+// around and merges them in chronological order. Logs are read per service with timestamps, so
+// lines of different services written at the same time keep the order of their service.
+func (cm *ComposeManager) ProjectIncidentLogs(projectDir string, around time.Time, window time.Duration) (*IncidentLogs, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	incident := &IncidentLogs{
+		Since:  around.Add(-window / 2),
+		Until:  around.Add(window / 2),
+		Failed: make(map[string]string),
+	}
+	for _, service := range project.ServiceNames() {
+		logs, err := composeOutput(projectDir, composeFilePath, "logs", "--no-color", "--timestamps",
+			"--since", incident.Since.Format(time.RFC3339), "--until", incident.Until.Format(time.RFC3339), service)
+		if err != nil {
+			incident.Failed[service] = strings.TrimSpace(err.Error() + ": " + logs)
+			continue
+		}
+		incident.Lines = append(incident.Lines, parseIncidentLines(service, logs)...)
+	}
+
+	sort.SliceStable(incident.Lines, func(i, j int) bool { return incident.Lines[i].Time.Before(incident.Lines[j].Time) })
+	return incident, nil
+}
+
+// parseIncidentLines splits the timestamped logs of a service into lines. A line without a
+// timestamp continues the previous one and takes its time, so it stays next to it once merged.
+func parseIncidentLines(service, logs string) []IncidentLine {
+	var lines []IncidentLine
+	for _, raw := range strings.Split(strings.TrimRight(logs, "\n"), "\n") {
+		if raw == "" {
+			continue
+		}
+
+		container, message, found := strings.Cut(raw, logPrefixSeparator)
+		if !found {
+			container, message = service, raw
+		}
+		line := IncidentLine{Service: service, Container: strings.TrimSpace(container), Message: message}
+
+		timestamp, rest, _ := strings.Cut(message, " ")
+		if parsed, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			line.Time = parsed
+			line.Message = rest
+		} else if len(lines) > 0 {
+			line.Time = lines[len(lines)-1].Time
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,93 @@
+package docker
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// Compose labels used to attach containers to a project
+const (
+	LabelComposeProject     = "com.docker.compose.project"
+	LabelComposeService     = "com.docker.compose.service"
+	LabelComposeWorkingDir  = "com.docker.compose.project.working_dir"
+	LabelComposeConfigFiles = "com.docker.compose.project.config_files"
+)
+
+// ContainerLabels holds the labels of a container related to a project
+type ContainerLabels struct {
+	Name    string
+	Service string
+	Labels  map[string]string
+	// Matched reports whether GetProjectContainers finds the container. Unmatched containers
+	// were started from the project directory under another project name.
+	Matched bool
+}
+
+// GetProjectLabels returns the project name dockyard matches containers with, and the labels of
+// the containers carrying it or started from the same directory
+func (cm *ComposeManager) GetProjectLabels(projectDir string) (string, []ContainerLabels, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var results []ContainerLabels
+	seen := make(map[string]bool)
+	for _, cont := range containers {
+		seen[cont.ID] = true
+		results = append(results, newContainerLabels(cont, true))
+	}
+
+	workingDir, err := filepath.Abs(projectDir)
+	if err != nil {
+		workingDir = projectDir
+	}
+
+	filterArgs := filters.NewArgs()
+	filterArgs.Add("label", fmt.Sprintf("%s=%s", LabelComposeWorkingDir, workingDir))
+	others, err := cm.dockerClient.ContainerList(cm.ctx, dockertypes.ContainerListOptions{
+		All:     true,
+		Filters: filterArgs,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list containers: %v", err)
+	}
+	for _, cont := range others {
+		if !seen[cont.ID] {
+			results = append(results, newContainerLabels(cont, false))
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Matched != results[j].Matched {
+			return results[i].Matched
+		}
+		return results[i].Name < results[j].Name
+	})
+
+	return project.Name, results, nil
+}
+
+func newContainerLabels(cont dockertypes.Container, matched bool) ContainerLabels {
+	name := cont.ID[:12]
+	if len(cont.Names) > 0 {
+		name = strings.TrimPrefix(cont.Names[0], "/")
+	}
+
+	return ContainerLabels{
+		Name:    name,
+		Service: cont.Labels[LabelComposeService],
+		Labels:  cont.Labels,
+		Matched: matched,
+	}
+}
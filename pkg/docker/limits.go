@@ -0,0 +1,190 @@
+package docker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// errContainerRemoved is returned by containerUsage for a container removed since it was listed
+var errContainerRemoved = errors.New("container was removed")
+
+// NearLimitThreshold is the usage percentage above which a container is flagged as near its limit
+const NearLimitThreshold = 90.0
+
+// ResourceUsage represents the configured limits and current usage of a container
+type ResourceUsage struct {
+	Name        string
+	Service     string
+	State       string
+	MemoryLimit int64   // bytes, 0 means no limit
+	MemoryUsage int64   // bytes
+	CPULimit    float64 // cores, 0 means no limit
+	CPUPercent  float64 // percentage of a single core
+	HasStats    bool
+}
+
+// MemoryPercent returns the memory usage as a percentage of the configured limit
+func (ru ResourceUsage) MemoryPercent() float64 {
+	if ru.MemoryLimit <= 0 {
+		return 0
+	}
+	return float64(ru.MemoryUsage) / float64(ru.MemoryLimit) * 100
+}
+
+// CPULimitPercent returns the CPU usage as a percentage of the configured limit
+func (ru ResourceUsage) CPULimitPercent() float64 {
+	if ru.CPULimit <= 0 {
+		return 0
+	}
+	return ru.CPUPercent / (ru.CPULimit * 100) * 100
+}
+
+// IsNearLimit reports whether memory or CPU usage exceeds NearLimitThreshold of its limit
+func (ru ResourceUsage) IsNearLimit() bool {
+	if !ru.HasStats {
+		return false
+	}
+	return ru.MemoryPercent() > NearLimitThreshold || ru.CPULimitPercent() > NearLimitThreshold
+}
+
+// GetProjectResourceUsage returns configured limits alongside current usage for every container in the project
+func (cm *ComposeManager) GetProjectResourceUsage(projectDir string) ([]ResourceUsage, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []ResourceUsage
+	for _, cont := range containers {
+		usage, err := cm.containerUsage(cont)
+		if errors.Is(err, errContainerRemoved) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, nil
+}
+
+// containerUsage returns the limits of a container and, when it is running, a stats snapshot
+func (cm *ComposeManager) containerUsage(cont dockertypes.Container) (ResourceUsage, error) {
+	usage := ResourceUsage{
+		Name:    strings.TrimPrefix(cont.Names[0], "/"),
+		Service: cont.Labels[LabelComposeService],
+		State:   cont.State,
+	}
+
+	inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+	if client.IsErrNotFound(err) {
+		return usage, errContainerRemoved
+	}
+	if err != nil {
+		return usage, fmt.Errorf("failed to inspect container %s: %v", usage.Name, err)
+	}
+
+	if inspect.HostConfig != nil {
+		usage.MemoryLimit = inspect.HostConfig.Memory
+		usage.CPULimit = cpuLimitFromResources(inspect.HostConfig.Resources)
+	}
+
+	// Stats are only meaningful for running containers. A container that stopped or was removed
+	// since it was listed is reported without stats, like any stopped container.
+	if cont.State == "running" {
+		stats, err := cm.getContainerStats(cont.ID)
+		if err == nil && !stats.Read.IsZero() {
+			usage.MemoryUsage = memoryUsage(stats)
+			usage.CPUPercent = cpuPercent(stats)
+			usage.HasStats = true
+		}
+	}
+
+	return usage, nil
+}
+
+// getContainerStats fetches a single stats snapshot for a container
+func (cm *ComposeManager) getContainerStats(containerID string) (*dockertypes.StatsJSON, error) {
+	resp, err := cm.dockerClient.ContainerStats(cm.ctx, containerID, false)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var stats dockertypes.StatsJSON
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("failed to decode stats: %v", err)
+	}
+
+	return &stats, nil
+}
+
+// cpuLimitFromResources converts the container CPU settings into a number of cores
+func cpuLimitFromResources(resources container.Resources) float64 {
+	if resources.NanoCPUs > 0 {
+		return float64(resources.NanoCPUs) / 1e9
+	}
+	if resources.CPUQuota > 0 && resources.CPUPeriod > 0 {
+		return float64(resources.CPUQuota) / float64(resources.CPUPeriod)
+	}
+	return 0
+}
+
+// memoryUsage returns the memory usage excluding page cache, matching `docker stats`
+func memoryUsage(stats *dockertypes.StatsJSON) int64 {
+	usage := int64(stats.MemoryStats.Usage)
+
+	// cgroup v2 reports inactive_file, cgroup v1 reports cache
+	if inactive, ok := stats.MemoryStats.Stats["inactive_file"]; ok && int64(inactive) < usage {
+		return usage - int64(inactive)
+	}
+	if cache, ok := stats.MemoryStats.Stats["cache"]; ok && int64(cache) < usage {
+		return usage - int64(cache)
+	}
+	return usage
+}
+
+// cpuPercent computes CPU usage as a percentage of a single core, matching `docker stats`
+func cpuPercent(stats *dockertypes.StatsJSON) float64 {
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	return cpuDelta / systemDelta * onlineCPUs * 100
+}
+
+// FormatBytes formats a byte count in a human-readable form
+func FormatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
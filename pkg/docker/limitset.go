@@ -0,0 +1,153 @@
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-units"
+)
+
+// ServiceLimits are resource limits to apply to a service's containers. Zero values are left unchanged.
+type ServiceLimits struct {
+	Memory string  `json:"memory,omitempty"`
+	CPUs   float64 `json:"cpus,omitempty"`
+}
+
+// IsEmpty reports whether no limit is set
+func (l ServiceLimits) IsEmpty() bool {
+	return l.Memory == "" && l.CPUs == 0
+}
+
+// MemoryBytes parses the memory limit, 0 when unset
+func (l ServiceLimits) MemoryBytes() (int64, error) {
+	if l.Memory == "" {
+		return 0, nil
+	}
+	memory, err := units.RAMInBytes(l.Memory)
+	if err != nil || memory <= 0 {
+		return 0, fmt.Errorf("invalid memory limit %q, use a size such as 512m or 2g", l.Memory)
+	}
+	return memory, nil
+}
+
+// Validate checks that the limits can be sent to the daemon
+func (l ServiceLimits) Validate() error {
+	if l.IsEmpty() {
+		return fmt.Errorf("no limit given, set a memory or cpus limit")
+	}
+	if l.CPUs < 0 {
+		return fmt.Errorf("invalid cpus limit %v", l.CPUs)
+	}
+	_, err := l.MemoryBytes()
+	return err
+}
+
+// LimitUpdate is the outcome of updating the limits of one container
+type LimitUpdate struct {
+	Container string
+	OldMemory int64   // bytes, 0 means no limit
+	OldCPUs   float64 // cores, 0 means no limit
+	Err       error
+	Warnings  []string
+}
+
+// UpdateServiceLimits applies the limits to the running containers of a service without
+// recreating them. It returns no updates when the service has no running container.
+func (cm *ComposeManager) UpdateServiceLimits(project *types.Project, service string, limits ServiceLimits) ([]LimitUpdate, error) {
+	if _, err := project.GetService(service); err != nil {
+		return nil, fmt.Errorf("service %s not found in project %s", service, project.Name)
+	}
+	if err := limits.Validate(); err != nil {
+		return nil, err
+	}
+	memory, _ := limits.MemoryBytes()
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []LimitUpdate
+	for _, cont := range containers {
+		if cont.Labels[LabelComposeService] != service || cont.State != "running" {
+			continue
+		}
+
+		update := LimitUpdate{Container: strings.TrimPrefix(cont.Names[0], "/")}
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect container %s: %v", update.Container, err)
+		}
+
+		var resources container.Resources
+		if inspect.HostConfig != nil {
+			current := inspect.HostConfig.Resources
+			update.OldMemory = current.Memory
+			update.OldCPUs = cpuLimitFromResources(current)
+
+			if memory > 0 && current.MemorySwap > 0 {
+				// Keep the swap allowance, the daemon rejects a memory limit above the swap limit
+				resources.MemorySwap = memory + max(current.MemorySwap-current.Memory, 0)
+			}
+			if limits.CPUs > 0 && current.CPUQuota > 0 {
+				// NanoCPUs cannot be combined with a quota, clear it
+				resources.CPUQuota = -1
+			}
+		}
+		resources.Memory = memory
+		resources.NanoCPUs = int64(limits.CPUs * 1e9)
+
+		response, err := cm.dockerClient.ContainerUpdate(cm.ctx, cont.ID, container.UpdateConfig{Resources: resources})
+		if err != nil {
+			update.Err = fmt.Errorf("daemon rejected the limits: %v", err)
+		}
+		update.Warnings = response.Warnings
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
+
+// SavePendingLimits records limits for a service that is not running, to apply when it starts
+func SavePendingLimits(projectName, service string, limits ServiceLimits) error {
+	if _, ok := Projects[projectName]; !ok {
+		return fmt.Errorf("project %s not found", projectName)
+	}
+
+	settings := ProjectsSettings[projectName]
+	if settings.PendingLimits == nil {
+		settings.PendingLimits = make(map[string]ServiceLimits)
+	}
+	settings.PendingLimits[service] = limits
+	ProjectsSettings[projectName] = settings
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save pending limits: %v", err)
+	}
+	return nil
+}
+
+// ClearPendingLimits forgets the pending limits of a service once they were applied
+func ClearPendingLimits(projectName, service string) error {
+	settings, ok := ProjectsSettings[projectName]
+	if !ok || settings.PendingLimits == nil {
+		return nil
+	}
+
+	delete(settings.PendingLimits, service)
+	if len(settings.PendingLimits) == 0 {
+		settings.PendingLimits = nil
+	}
+	if settings.IsEmpty() {
+		delete(ProjectsSettings, projectName)
+	} else {
+		ProjectsSettings[projectName] = settings
+	}
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save projects: %v", err)
+	}
+	return nil
+}
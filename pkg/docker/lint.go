@@ -0,0 +1,180 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Severities of a LintFinding, from most to least serious
+const (
+	LintHigh   = "high"
+	LintMedium = "medium"
+	LintLow    = "low"
+)
+
+// LintFinding is a best-practice rule a service of a compose file breaks
+type LintFinding struct {
+	Service  string
+	Rule     string
+	Severity string
+	Message  string
+}
+
+// rawComposeEnvironment is the environment of each service as written in the compose file,
+// before variables are interpolated
+type rawComposeEnvironment struct {
+	Services map[string]struct {
+		Environment any `yaml:"environment"`
+	} `yaml:"services"`
+}
+
+// LintProject checks the services of a project against best practices: pinned image tags,
+// healthchecks, restart policies, privileged mode, host networking and secrets written in
+// the compose file. Findings are sorted by severity, then service.
+func LintProject(projectDir string, project *types.Project) ([]LintFinding, error) {
+	hardcoded, err := hardcodedSecrets(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []LintFinding
+	add := func(service, rule, severity, message string) {
+		findings = append(findings, LintFinding{Service: service, Rule: rule, Severity: severity, Message: message})
+	}
+
+	for _, service := range project.Services {
+		if service.Build == nil && service.Image != "" && usesLatestTag(service.Image) {
+			add(service.Name, "latest-tag", LintMedium,
+				fmt.Sprintf("image %s is not pinned, use a specific tag or digest for reproducible deployments", service.Image))
+		}
+		if service.HealthCheck == nil || service.HealthCheck.Disable {
+			add(service.Name, "no-healthcheck", LintLow,
+				"no healthcheck, dependents cannot wait for the service to be ready unless the image defines one")
+		}
+		if service.Restart == "" && (service.Deploy == nil || service.Deploy.RestartPolicy == nil) {
+			add(service.Name, "no-restart", LintLow,
+				"no restart policy, the container stays down after a crash or a daemon restart")
+		}
+		if service.Privileged {
+			add(service.Name, "privileged", LintHigh,
+				"runs privileged with full access to the host, grant only the needed cap_add and devices")
+		}
+		if service.NetworkMode == "host" {
+			add(service.Name, "host-network", LintMedium,
+				"uses the host network, which bypasses network isolation and port mapping")
+		}
+		for _, key := range hardcoded[service.Name] {
+			add(service.Name, "hardcoded-secret", LintHigh,
+				fmt.Sprintf("%s is set in the compose file, reference a variable from .env or use secrets instead", key))
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if lintRank(findings[i].Severity) != lintRank(findings[j].Severity) {
+			return lintRank(findings[i].Severity) < lintRank(findings[j].Severity)
+		}
+		return findings[i].Service < findings[j].Service
+	})
+	return findings, nil
+}
+
+// usesLatestTag reports whether an image reference has no tag or digest, or the latest tag
+func usesLatestTag(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	// The tag follows the last colon after the last slash, a colon before it is a registry port
+	name := image[strings.LastIndex(image, "/")+1:]
+	_, tag, hasTag := strings.Cut(name, ":")
+	return !hasTag || tag == "latest"
+}
+
+// hardcodedSecrets returns, per service, the secret environment keys whose value is written
+// literally in the compose files, included ones too, instead of referencing a variable. Keys such
+// as DB_PASSWORD_FILE and values that are file paths point to a secret rather than hold it.
+func hardcodedSecrets(projectDir string) (map[string][]string, error) {
+	composeFiles, err := utils.GetAllComposeFiles(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	redactor := utils.NewRedactor(nil)
+	found := make(map[string]map[string]bool)
+	for _, composeFilePath := range composeFiles {
+		content, err := os.ReadFile(composeFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read compose file: %v", err)
+		}
+
+		var raw rawComposeEnvironment
+		if err := yaml.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse compose file %s: %v", composeFilePath, err)
+		}
+
+		for name, service := range raw.Services {
+			for key, value := range rawEnvironment(service.Environment) {
+				if !redactor.IsSecretKey(key) || value == "" || strings.Contains(value, "$") || looksLikePath(value) {
+					continue
+				}
+				if found[name] == nil {
+					found[name] = make(map[string]bool)
+				}
+				found[name][key] = true
+			}
+		}
+	}
+
+	secrets := make(map[string][]string)
+	for name, keys := range found {
+		secrets[name] = slices.Sorted(maps.Keys(keys))
+	}
+	return secrets, nil
+}
+
+// looksLikePath reports whether an environment value is a file path, such as a mounted secret
+func looksLikePath(value string) bool {
+	return strings.HasPrefix(value, "/") || strings.HasPrefix(value, "./") ||
+		strings.HasPrefix(value, "../") || strings.HasPrefix(value, "~/")
+}
+
+// rawEnvironment converts an environment written as a mapping or as a list of KEY=value
+// entries to a map. Keys without a value are taken from the shell and map to "".
+func rawEnvironment(environment any) map[string]string {
+	env := make(map[string]string)
+	switch entries := environment.(type) {
+	case map[string]any:
+		for key, value := range entries {
+			if value != nil {
+				env[key] = fmt.Sprint(value)
+			} else {
+				env[key] = ""
+			}
+		}
+	case []any:
+		for _, entry := range entries {
+			key, value, _ := strings.Cut(fmt.Sprint(entry), "=")
+			env[key] = value
+		}
+	}
+	return env
+}
+
+// lintRank orders severities from most to least serious
+func lintRank(severity string) int {
+	switch severity {
+	case LintHigh:
+		return 0
+	case LintMedium:
+		return 1
+	default:
+		return 2
+	}
+}
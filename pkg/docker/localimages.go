@@ -0,0 +1,62 @@
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"github.com/docker/docker/client"
+)
+
+// LocalImage reports whether the image of a service is present in the local image store
+type LocalImage struct {
+	Service string
+	Image   string
+	// Built reports a service compose builds, its image is created rather than pulled
+	Built   bool
+	Present bool
+}
+
+// CheckLocalImages inspects the local image store for the image of every service, so that it is
+// known before starting whether the project can come up without network access
+func (cm *ComposeManager) CheckLocalImages(project *types.Project) ([]LocalImage, error) {
+	var images []LocalImage
+	for _, service := range project.Services {
+		image := LocalImage{Service: service.Name, Image: service.Image, Built: service.Build != nil}
+		if image.Image == "" && image.Built {
+			image.Image = fmt.Sprintf("%s-%s", project.Name, service.Name)
+		}
+		if image.Image == "" {
+			continue
+		}
+
+		_, _, err := cm.dockerClient.ImageInspectWithRaw(cm.ctx, image.Image)
+		switch {
+		case err == nil:
+			image.Present = true
+		case !client.IsErrNotFound(err):
+			return nil, fmt.Errorf("failed to inspect image %s: %v", image.Image, err)
+		}
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+// requireLocalImages fails when an image that compose would pull is missing locally
+func (cm *ComposeManager) requireLocalImages(project *types.Project) error {
+	images, err := cm.CheckLocalImages(project)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, image := range images {
+		if !image.Present && !image.Built {
+			missing = append(missing, image.Image)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("offline start refused, image(s) not present locally: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package docker
+
+import (
+	"archive/zip"
+	"dockyard/pkg/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// LogBundleManifest describes the content of a logs bundle, stored as manifest.json in the archive
+type LogBundleManifest struct {
+	Project   string             `json:"project"`
+	CreatedAt time.Time          `json:"created_at"`
+	Since     string             `json:"since,omitempty"`
+	Redacted  bool               `json:"redacted"`
+	Config    string             `json:"config"`
+	Services  []LogBundleService `json:"services"`
+}
+
+// LogBundleService is the log file of one service in a logs bundle
+type LogBundleService struct {
+	Service string `json:"service"`
+	File    string `json:"file"`
+	Lines   int    `json:"lines"`
+	Error   string `json:"error,omitempty"`
+}
+
+// LogBundleOptions controls what a logs bundle contains
+type LogBundleOptions struct {
+	// Since limits the logs like docker compose logs --since
+	Since string
+	// Services limits the logs to the given services, all services are bundled when empty
+	Services []string
+	// Redact masks secret values in the logs and the compose config
+	Redact bool
+	// Force overwrites an existing archive
+	Force bool
+}
+
+// BundleLogs writes a zip archive holding the logs of each service of a project in
+// logs/<service>.log, the output of docker compose config and a manifest. A service whose logs
+// cannot be read is recorded in the manifest instead of failing the bundle.
+func (cm *ComposeManager) BundleLogs(projectName, projectDir, outPath string, options LogBundleOptions) (*LogBundleManifest, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	services := options.Services
+	if len(services) == 0 {
+		services = project.ServiceNames()
+	}
+	for _, service := range services {
+		if _, err := project.GetService(service); err != nil {
+			return nil, fmt.Errorf("service %s not found in project %s", service, project.Name)
+		}
+	}
+	since, redact := options.Since, options.Redact
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	redactor := utils.NewRedactor(ProjectEnvironment(project))
+	mask := func(text string) string {
+		if redact {
+			return redactor.Redact(text)
+		}
+		return text
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !options.Force {
+		flags |= os.O_EXCL
+	}
+	file, err := os.OpenFile(outPath, flags, 0600)
+	if errors.Is(err, fs.ErrExist) {
+		return nil, fmt.Errorf("%s already exists, use --force to overwrite it", outPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %v", outPath, err)
+	}
+	archive := zip.NewWriter(file)
+
+	manifest := &LogBundleManifest{
+		Project:   projectName,
+		CreatedAt: time.Now(),
+		Since:     since,
+		Redacted:  redact,
+		Config:    "compose-config.yaml",
+	}
+
+	bundleErr := func() error {
+		config, err := composeOutput(projectDir, composeFilePath, "config")
+		if err != nil {
+			config = fmt.Sprintf("# docker compose config failed: %v\n%s", err, config)
+		}
+		// Masked per line, a "key:" line must not pair with the value on the next line
+		lines := strings.Split(config, "\n")
+		for i, line := range lines {
+			lines[i] = mask(line)
+		}
+		if err := writeZipFile(archive, manifest.Config, strings.Join(lines, "\n")); err != nil {
+			return err
+		}
+
+		for _, service := range services {
+			entry := LogBundleService{Service: service, File: "logs/" + service + ".log"}
+
+			args := []string{"logs", "--no-color", "--timestamps"}
+			if since != "" {
+				args = append(args, "--since", since)
+			}
+			logs, err := composeOutput(projectDir, composeFilePath, append(args, service)...)
+			if err != nil {
+				entry.Error = err.Error()
+			}
+			entry.Lines = strings.Count(logs, "\n")
+
+			if err := writeZipFile(archive, entry.File, mask(logs)); err != nil {
+				return err
+			}
+			manifest.Services = append(manifest.Services, entry)
+		}
+
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := writeZipFile(archive, "manifest.json", string(data)); err != nil {
+			return err
+		}
+		return archive.Close()
+	}()
+
+	if closeErr := file.Close(); bundleErr == nil {
+		bundleErr = closeErr
+	}
+	if bundleErr != nil {
+		os.Remove(outPath)
+		return nil, fmt.Errorf("failed to write %s: %v", outPath, bundleErr)
+	}
+	return manifest, nil
+}
+
+// composeOutput runs a docker compose command in the project directory and returns its output
+func composeOutput(projectDir, composeFilePath string, command ...string) (string, error) {
+	cmd := exec.Command(CommandDocker, utils.ComposeArgs(composeFilePath, command...)...)
+	cmd.Dir = projectDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("docker compose %s failed: %v", command[0], err)
+	}
+	return string(output), nil
+}
+
+// writeZipFile adds a file with the given content to the archive
+func writeZipFile(archive *zip.Writer, name, content string) error {
+	writer, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write([]byte(content))
+	return err
+}
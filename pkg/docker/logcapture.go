@@ -0,0 +1,147 @@
+package docker
+
+import (
+	"bufio"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// logRotations is the number of rotated files kept per service
+const logRotations = 3
+
+// CaptureLogs follows the logs of a project and writes the lines of each service to
+// <outDir>/<service>.log, rotating a file once it exceeds maxSize bytes. It runs until the logs
+// stream ends or the process is asked to terminate.
+func CaptureLogs(projectDir, outDir string, maxSize int64) error {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %v", outDir, err)
+	}
+
+	cmd := exec.Command(CommandDocker, utils.ComposeArgs(composeFilePath, "logs", "-f", "--no-color", "--timestamps", "--tail", "0")...)
+	cmd.Dir = projectDir
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to follow logs: %v", err)
+	}
+
+	// Stop following when asked to terminate, which ends the scan loop below
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		if _, ok := <-signals; ok {
+			cmd.Process.Kill()
+		}
+	}()
+
+	files := make(map[string]*rotatingFile)
+	defer func() {
+		for _, file := range files {
+			file.Close()
+		}
+	}()
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		prefix, message, found := strings.Cut(scanner.Text(), logPrefixSeparator)
+		if !found {
+			continue
+		}
+		service := replicaSuffixPattern.ReplaceAllString(strings.TrimSpace(prefix), "")
+
+		file, ok := files[service]
+		if !ok {
+			file = &rotatingFile{path: filepath.Join(outDir, service+".log"), maxSize: maxSize}
+			files[service] = file
+		}
+		if err := file.WriteLine(message); err != nil {
+			cmd.Process.Kill()
+			cmd.Wait()
+			return err
+		}
+	}
+
+	cmd.Wait()
+	return nil
+}
+
+// rotatingFile appends lines to a file, moving it to .1, .2, ... once it reaches maxSize
+type rotatingFile struct {
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+// WriteLine appends a line, rotating the file first when it is full
+func (rf *rotatingFile) WriteLine(line string) error {
+	if rf.file == nil {
+		if err := rf.open(); err != nil {
+			return err
+		}
+	}
+
+	if rf.maxSize > 0 && rf.size+int64(len(line))+1 > rf.maxSize && rf.size > 0 {
+		if err := rf.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := fmt.Fprintln(rf.file, line)
+	rf.size += int64(n)
+	return err
+}
+
+func (rf *rotatingFile) open() error {
+	file, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	rf.file, rf.size = file, info.Size()
+	return nil
+}
+
+// rotate shifts the existing rotations, dropping the oldest one, and starts a new file
+func (rf *rotatingFile) rotate() error {
+	rf.Close()
+
+	for i := logRotations - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", rf.path, i), fmt.Sprintf("%s.%d", rf.path, i+1))
+	}
+	if err := os.Rename(rf.path, rf.path+".1"); err != nil {
+		return err
+	}
+	return rf.open()
+}
+
+// Close closes the current file
+func (rf *rotatingFile) Close() error {
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
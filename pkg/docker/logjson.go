@@ -0,0 +1,50 @@
+package docker
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// JSONLogLine is a structured log line emitted with its compose metadata
+type JSONLogLine struct {
+	Service   string          `json:"service"`
+	Container string          `json:"container"`
+	Timestamp string          `json:"timestamp,omitempty"`
+	Log       json.RawMessage `json:"log"`
+}
+
+// formatJSONLogLine wraps a log line whose message is a JSON object with the service, container
+// and timestamp it came from. Other lines are returned unchanged.
+func formatJSONLogLine(line string) string {
+	prefix, message, found := strings.Cut(line, logPrefixSeparator)
+	if !found {
+		return line
+	}
+
+	container := strings.TrimSpace(prefix)
+	entry := JSONLogLine{
+		Service:   replicaSuffixPattern.ReplaceAllString(container, ""),
+		Container: container,
+	}
+
+	// With --timestamps, compose puts an RFC 3339 timestamp before the message
+	if timestamp, rest, ok := strings.Cut(message, " "); ok {
+		if _, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			entry.Timestamp = timestamp
+			message = rest
+		}
+	}
+
+	message = strings.TrimSpace(message)
+	if !strings.HasPrefix(message, "{") || !json.Valid([]byte(message)) {
+		return line
+	}
+	entry.Log = json.RawMessage(message)
+
+	output, err := json.Marshal(entry)
+	if err != nil {
+		return line
+	}
+	return string(output)
+}
@@ -0,0 +1,44 @@
+package docker
+
+import (
+	"dockyard/pkg/ui"
+	"regexp"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// logPrefixSeparator separates the container name from the message in compose logs
+const logPrefixSeparator = " | "
+
+// replicaSuffixPattern matches the replica number compose appends to container names
+var replicaSuffixPattern = regexp.MustCompile(`-\d+$`)
+
+// logPrefixer rewrites compose log lines with a colored container prefix aligned in a column
+type logPrefixer struct {
+	width int
+}
+
+// newLogPrefixer sizes the prefix column for the first replica of each service of the project
+func newLogPrefixer(project *types.Project) *logPrefixer {
+	width := 0
+	for _, service := range project.Services {
+		width = max(width, len(service.Name)+len("-1"))
+	}
+	return &logPrefixer{width: width}
+}
+
+// Format recolors and realigns the prefix of a log line. Lines without a prefix are kept as is.
+func (p *logPrefixer) Format(line string) string {
+	prefix, message, found := strings.Cut(line, logPrefixSeparator)
+	if !found {
+		return line
+	}
+
+	prefix = strings.TrimSpace(prefix)
+	// Grow the column when a replica or container name is longer than expected
+	p.width = max(p.width, len(prefix))
+
+	service := replicaSuffixPattern.ReplaceAllString(prefix, "")
+	return ui.RenderServicePrefix(service, prefix, p.width) + " " + message
+}
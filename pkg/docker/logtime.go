@@ -0,0 +1,32 @@
+package docker
+
+import (
+	"strings"
+	"time"
+)
+
+// localLogTimeLayout is the layout timestamps are rewritten with, keeping the offset so the zone is visible
+const localLogTimeLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// localizeLogTimestamp rewrites the RFC 3339 timestamp compose puts before the message with
+// --timestamps to the local time zone. Lines without a parseable timestamp are returned unchanged.
+func localizeLogTimestamp(line string) string {
+	prefix, message, found := strings.Cut(line, logPrefixSeparator)
+	if !found {
+		prefix, message = "", line
+	} else {
+		prefix += logPrefixSeparator
+	}
+
+	timestamp, rest, hasMessage := strings.Cut(message, " ")
+	parsed, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return line
+	}
+
+	localized := prefix + parsed.Local().Format(localLogTimeLayout)
+	if hasMessage {
+		localized += " " + rest
+	}
+	return localized
+}
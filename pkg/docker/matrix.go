@@ -0,0 +1,125 @@
+package docker
+
+import (
+	"bytes"
+	"dockyard/pkg/utils"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// DefaultEnvFilePattern matches the per-environment env files tested by default, e.g. .env.dev
+const DefaultEnvFilePattern = ".env.*"
+
+// unsetVariablePattern matches the warning compose prints for each variable it cannot resolve
+var unsetVariablePattern = regexp.MustCompile(`"([A-Za-z_][A-Za-z0-9_]*)" variable is not set`)
+
+// EnvironmentResult is the outcome of rendering the compose config with one env file
+type EnvironmentResult struct {
+	EnvFile    string
+	Unresolved []string
+	// Error is the output of docker compose config when it rejects the configuration
+	Error string
+}
+
+// Valid reports whether the configuration rendered and every variable was resolved
+func (r EnvironmentResult) Valid() bool {
+	return r.Error == "" && len(r.Unresolved) == 0
+}
+
+// FindEnvFiles returns the env files in the project directory matching pattern, leaving out the
+// .env.example template
+func FindEnvFiles(projectDir, pattern string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(projectDir, pattern))
+	if err != nil {
+		return nil, fmt.Errorf("invalid env file pattern %s: %v", pattern, err)
+	}
+
+	var envFiles []string
+	for _, match := range matches {
+		if filepath.Base(match) == EnvExampleFile {
+			continue
+		}
+		if info, err := os.Stat(match); err == nil && !info.IsDir() {
+			envFiles = append(envFiles, match)
+		}
+	}
+	sort.Strings(envFiles)
+	return envFiles, nil
+}
+
+// TestEnvironments runs docker compose config against each env file and reports whether it
+// produces a valid configuration with every variable resolved
+func TestEnvironments(projectDir string, envFiles []string) ([]EnvironmentResult, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := ComposeVariables(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]EnvironmentResult, 0, len(envFiles))
+	for _, envFile := range envFiles {
+		result, err := testEnvironment(projectDir, composeFilePath, envFile, references)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// testEnvironment renders the config with a single env file
+func testEnvironment(projectDir, composeFilePath, envFile string, references map[string]bool) (EnvironmentResult, error) {
+	result := EnvironmentResult{EnvFile: envFile}
+
+	env, err := readEnvFile(envFile)
+	if err != nil {
+		return result, err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(CommandDocker, utils.ComposeArgs(composeFilePath, "--env-file", envFile, "config", "--quiet")...)
+	cmd.Dir = projectDir
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	unresolved := make(map[string]bool)
+	for _, match := range unsetVariablePattern.FindAllStringSubmatch(stderr.String(), -1) {
+		unresolved[match[1]] = true
+	}
+	// Compose stops at the first error, so also check references statically
+	for name, hasDefault := range references {
+		if _, ok := env[name]; ok || hasDefault {
+			continue
+		}
+		if _, ok := os.LookupEnv(name); !ok {
+			unresolved[name] = true
+		}
+	}
+	result.Unresolved = slices.Sorted(maps.Keys(unresolved))
+
+	if runErr != nil {
+		var errorLines []string
+		for _, line := range strings.Split(strings.TrimSpace(stderr.String()), "\n") {
+			if line != "" && !unsetVariablePattern.MatchString(line) {
+				errorLines = append(errorLines, line)
+			}
+		}
+		result.Error = strings.Join(errorLines, "\n")
+		if result.Error == "" {
+			result.Error = runErr.Error()
+		}
+	}
+
+	return result, nil
+}
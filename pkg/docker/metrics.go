@@ -0,0 +1,73 @@
+package docker
+
+import (
+	"github.com/compose-spec/compose-go/types"
+)
+
+// ProjectMetrics summarizes the structure of a compose project
+type ProjectMetrics struct {
+	Services        int
+	Networks        int
+	Volumes         int
+	EnvVars         int
+	WithHealthcheck int
+	WithLimits      int
+	BuiltServices   int
+	// ImagesByRegistry counts the pulled images of each registry
+	ImagesByRegistry map[string]int
+}
+
+// ExternalImages returns the number of images pulled from registries other than Docker Hub
+func (m ProjectMetrics) ExternalImages() int {
+	count := 0
+	for registry, images := range m.ImagesByRegistry {
+		if registry != DefaultRegistry {
+			count += images
+		}
+	}
+	return count
+}
+
+// ComputeMetrics computes the structural metrics of a loaded project
+func ComputeMetrics(project *types.Project) ProjectMetrics {
+	metrics := ProjectMetrics{
+		Services:         len(project.Services),
+		Networks:         len(project.Networks),
+		Volumes:          len(project.Volumes),
+		ImagesByRegistry: make(map[string]int),
+	}
+
+	for _, service := range project.Services {
+		metrics.EnvVars += len(service.Environment)
+
+		if service.HealthCheck != nil && !service.HealthCheck.Disable {
+			metrics.WithHealthcheck++
+		}
+		if hasResourceLimits(service) {
+			metrics.WithLimits++
+		}
+
+		if service.Build != nil {
+			metrics.BuiltServices++
+			continue
+		}
+		if service.Image != "" {
+			metrics.ImagesByRegistry[ImageRegistry(service.Image)]++
+		}
+	}
+
+	return metrics
+}
+
+// hasResourceLimits reports whether a service limits its CPU or memory, either through the
+// legacy cpus and mem_limit keys or deploy.resources.limits
+func hasResourceLimits(service types.ServiceConfig) bool {
+	if service.CPUS > 0 || service.MemLimit > 0 {
+		return true
+	}
+	if service.Deploy == nil || service.Deploy.Resources.Limits == nil {
+		return false
+	}
+	limits := service.Deploy.Resources.Limits
+	return limits.NanoCPUs != "" || limits.MemoryBytes > 0
+}
@@ -0,0 +1,86 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types/mount"
+)
+
+// ContainerMount is a mount of a running or stopped container as reported by the daemon
+type ContainerMount struct {
+	Type        string
+	Source      string // host path for binds, volume name for volumes, empty for tmpfs
+	Destination string
+	ReadOnly    bool
+	// Missing reports a bind mount whose host source does not exist
+	Missing bool
+}
+
+// ContainerMounts lists the mounts of one container of a project
+type ContainerMounts struct {
+	Name    string
+	Service string
+	Mounts  []ContainerMount
+}
+
+// GetProjectMounts inspects each container of the project and returns its mounts, sorted by
+// service and container name
+func (cm *ComposeManager) GetProjectMounts(projectDir string) ([]ContainerMounts, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ContainerMounts
+	for _, cont := range containers {
+		mounts := ContainerMounts{
+			Name:    strings.TrimPrefix(cont.Names[0], "/"),
+			Service: cont.Labels[LabelComposeService],
+		}
+
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect container %s: %v", mounts.Name, err)
+		}
+
+		for _, point := range inspect.Mounts {
+			m := ContainerMount{
+				Type:        string(point.Type),
+				Source:      point.Source,
+				Destination: point.Destination,
+				ReadOnly:    !point.RW,
+			}
+			switch point.Type {
+			case mount.TypeVolume:
+				m.Source = point.Name
+			case mount.TypeBind:
+				if _, err := os.Stat(point.Source); err != nil {
+					m.Missing = true
+				}
+			}
+			mounts.Mounts = append(mounts.Mounts, m)
+		}
+		sort.Slice(mounts.Mounts, func(i, j int) bool {
+			return mounts.Mounts[i].Destination < mounts.Mounts[j].Destination
+		})
+
+		result = append(result, mounts)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Service != result[j].Service {
+			return result[i].Service < result[j].Service
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package docker
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/AlecAivazis/survey/v2"
+	dockertypes "github.com/docker/docker/api/types"
+)
+
+// networkConflictPatterns match the compose errors about a network that exists but was not created
+// for the project, capturing the network name
+var networkConflictPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`network with name "?([\w.-]+)"? (?:already )?exists`),
+	regexp.MustCompile(`network "?([\w.-]+)"? (?:already exists|was found but has incorrect label)`),
+}
+
+// NetworkConflictError reports that docker compose refused to use an existing network
+type NetworkConflictError struct {
+	Network string
+	Err     error
+}
+
+func (e *NetworkConflictError) Error() string {
+	return fmt.Sprintf("network %s already exists and was not created by this project: %v", e.Network, e.Err)
+}
+
+func (e *NetworkConflictError) Unwrap() error {
+	return e.Err
+}
+
+// detectNetworkConflict returns the network named in a network conflict error output, if any
+func detectNetworkConflict(errorOutput string) (string, bool) {
+	for _, pattern := range networkConflictPatterns {
+		if match := pattern.FindStringSubmatch(errorOutput); match != nil {
+			return match[1], true
+		}
+	}
+	return "", false
+}
+
+// resolveNetworkConflict removes a conflicting network once the user agrees, returning true when
+// the operation can be retried. A network that containers are still connected to is kept and its
+// containers are listed.
+func (cm *ComposeManager) resolveNetworkConflict(network string) bool {
+	inspect, err := cm.dockerClient.NetworkInspect(cm.ctx, network, dockertypes.NetworkInspectOptions{})
+	if err != nil {
+		fmt.Printf("⚠️  Network conflict on %s, but the network could not be inspected: %v\n", network, err)
+		return false
+	}
+
+	if len(inspect.Containers) > 0 {
+		var holders []string
+		for _, endpoint := range inspect.Containers {
+			holders = append(holders, endpoint.Name)
+		}
+		sort.Strings(holders)
+
+		fmt.Printf("⚠️  Network %s already exists and is used by %d container(s):\n", network, len(holders))
+		for _, holder := range holders {
+			fmt.Printf("   • %s\n", holder)
+		}
+		fmt.Printf("💡 Stop those containers or run 'docker network disconnect %s <container>', then remove the network\n", network)
+		return false
+	}
+
+	remove := false
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("Network %s already exists but no container uses it. Remove it and retry?", network),
+		Default: true,
+	}
+	if err := survey.AskOne(prompt, &remove); err != nil || !remove {
+		return false
+	}
+
+	if err := cm.dockerClient.NetworkRemove(cm.ctx, inspect.ID); err != nil {
+		fmt.Printf("❌ Failed to remove network %s: %v\n", network, err)
+		return false
+	}
+	fmt.Printf("🗑️  Removed network %s\n", network)
+	return true
+}
@@ -0,0 +1,187 @@
+package docker
+
+import (
+	"context"
+	"dockyard/pkg/utils"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// Results of a service-to-service connectivity test
+const (
+	LinkReachable   = "reachable"
+	LinkResolved    = "resolved"
+	LinkDNSFailed   = "dns failed"
+	LinkUnreachable = "unreachable"
+	LinkUntested    = "untested"
+)
+
+// linkProbeTimeout bounds a single probe run inside a container
+const linkProbeTimeout = 15 * time.Second
+
+// linkProbeWorkers bounds the number of probes run concurrently
+const linkProbeWorkers = 8
+
+// linkProbeScript resolves the target and connects to it with whatever tool the image provides.
+// Exit codes: 0 reachable, 10 DNS failure, 11 unreachable, 12 resolved only, 13 no usable tool.
+const linkProbeScript = `h="$1"; p="$2"; resolved=
+if command -v getent >/dev/null 2>&1; then getent hosts "$h" >/dev/null 2>&1 || exit 10; resolved=1
+elif command -v nslookup >/dev/null 2>&1; then nslookup "$h" >/dev/null 2>&1 || exit 10; resolved=1
+fi
+if [ -n "$p" ] && command -v nc >/dev/null 2>&1; then nc -z -w 3 "$h" "$p" >/dev/null 2>&1 && exit 0 || exit 11; fi
+if command -v ping >/dev/null 2>&1; then ping -c 1 -W 3 "$h" >/dev/null 2>&1 && exit 0 || exit 11; fi
+if [ -n "$p" ] && command -v bash >/dev/null 2>&1; then bash -c "exec 3<>/dev/tcp/$h/$p" >/dev/null 2>&1 && exit 0 || exit 11; fi
+[ -n "$resolved" ] && exit 12
+exit 13`
+
+// ServiceLink is the outcome of probing one service from another
+type ServiceLink struct {
+	From    string
+	To      string
+	Network string
+	Port    string
+	Result  string
+	Detail  string
+}
+
+// TestServiceConnectivity probes every pair of services sharing a network by running a small
+// script in the source service's container. Probes run concurrently, results keep the order of
+// the services.
+func (cm *ComposeManager) TestServiceConnectivity(projectDir string) ([]ServiceLink, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []ServiceLink
+	for _, from := range project.Services {
+		for _, to := range project.Services {
+			if from.Name == to.Name {
+				continue
+			}
+
+			network, ok := sharedNetwork(from, to)
+			if !ok {
+				continue
+			}
+
+			links = append(links, ServiceLink{From: from.Name, To: to.Name, Network: network, Port: servicePort(to)})
+		}
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, linkProbeWorkers)
+	for i := range links {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(link *ServiceLink) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			link.Result, link.Detail = probeServiceLink(projectDir, composeFilePath, *link)
+		}(&links[i])
+	}
+	wg.Wait()
+
+	return links, nil
+}
+
+// probeServiceLink runs the probe script in the source service and interprets its exit code
+func probeServiceLink(projectDir, composeFilePath string, link ServiceLink) (string, string) {
+	ctx, cancel := context.WithTimeout(context.Background(), linkProbeTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, CommandDocker, utils.ComposeArgs(composeFilePath,
+		"exec", "-T", link.From, "sh", "-c", linkProbeScript, "probe", link.To, link.Port)...)
+	cmd.Dir = projectDir
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return LinkReachable, ""
+	}
+	if ctx.Err() != nil {
+		return LinkUnreachable, "probe timed out"
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return LinkUntested, err.Error()
+	}
+
+	switch exitErr.ExitCode() {
+	case 10:
+		return LinkDNSFailed, fmt.Sprintf("%s does not resolve", link.To)
+	case 11:
+		if link.Port != "" {
+			return LinkUnreachable, fmt.Sprintf("no answer on port %s", link.Port)
+		}
+		return LinkUnreachable, "no answer to ping"
+	case 12:
+		return LinkResolved, "no nc, ping or bash in the image to test the connection"
+	case 13:
+		return LinkUntested, "no tool in the image to resolve or reach other services"
+	case 126, 127:
+		return LinkUntested, "the image has no shell"
+	default:
+		return LinkUntested, strings.TrimSpace(string(output))
+	}
+}
+
+// sharedNetwork returns the first network, by name, both services are attached to
+func sharedNetwork(a, b types.ServiceConfig) (string, bool) {
+	networksA, networksB := serviceNetworks(a), serviceNetworks(b)
+
+	var shared []string
+	for network := range networksA {
+		if networksB[network] {
+			shared = append(shared, network)
+		}
+	}
+	if len(shared) == 0 {
+		return "", false
+	}
+
+	sort.Strings(shared)
+	return shared[0], true
+}
+
+// serviceNetworks returns the compose networks of a service. Services without networks use
+// the default network of the project. Services with any network_mode, bridge included, which
+// is Docker's default bridge without service name resolution, are not on a compose network.
+func serviceNetworks(service types.ServiceConfig) map[string]bool {
+	networks := make(map[string]bool)
+	if service.NetworkMode != "" {
+		return networks
+	}
+
+	if len(service.Networks) == 0 {
+		networks["default"] = true
+	}
+	for network := range service.Networks {
+		networks[network] = true
+	}
+	return networks
+}
+
+// servicePort returns the container port a service listens on, if declared
+func servicePort(service types.ServiceConfig) string {
+	if len(service.Ports) > 0 {
+		return fmt.Sprint(service.Ports[0].Target)
+	}
+	if len(service.Expose) > 0 {
+		port, _, _ := strings.Cut(service.Expose[0], "/")
+		port, _, _ = strings.Cut(port, "-")
+		return port
+	}
+	return ""
+}
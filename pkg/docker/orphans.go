@@ -0,0 +1,177 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	dockertypes "github.com/docker/docker/api/types"
+)
+
+// OrphanVolume represents a project volume that no container is using
+type OrphanVolume struct {
+	Name string
+	Size int64 // bytes, -1 when the driver does not report usage
+}
+
+// HasData reports whether the volume may contain data. Volumes of unknown size are
+// treated as containing data so they are never offered as safe to remove.
+func (v OrphanVolume) HasData() bool {
+	return v.Size != 0
+}
+
+// OrphanImage represents an image built for the project that is no longer referenced
+type OrphanImage struct {
+	ID   string
+	Tags []string
+	Size int64
+}
+
+// ProjectOrphans holds the unused resources of a project
+type ProjectOrphans struct {
+	ProjectName string
+	Volumes     []OrphanVolume
+	Images      []OrphanImage
+}
+
+// IsEmpty reports whether no orphaned resources were found
+func (o *ProjectOrphans) IsEmpty() bool {
+	return len(o.Volumes) == 0 && len(o.Images) == 0
+}
+
+// FindProjectOrphans returns the project's volumes without attached containers and the
+// images built for the project that are no longer referenced by a service or container
+func (cm *ComposeManager) FindProjectOrphans(projectDir string) (*ProjectOrphans, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cm.ensureDockerRunning(); err != nil {
+		return nil, fmt.Errorf("docker is not accessible: %v", err)
+	}
+
+	usage, err := cm.dockerClient.DiskUsage(cm.ctx, dockertypes.DiskUsageOptions{
+		Types: []dockertypes.DiskUsageObject{dockertypes.VolumeObject, dockertypes.ImageObject, dockertypes.ContainerObject},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk usage: %v", err)
+	}
+
+	orphans := &ProjectOrphans{ProjectName: project.Name}
+
+	// Volumes declared in the compose file (external ones are not ours to clean up)
+	declaredVolumes := make(map[string]bool)
+	for _, vol := range project.Volumes {
+		if !vol.External.External {
+			declaredVolumes[vol.Name] = true
+		}
+	}
+
+	for _, vol := range usage.Volumes {
+		belongsToProject := declaredVolumes[vol.Name] ||
+			vol.Labels["com.docker.compose.project"] == project.Name
+		if !belongsToProject || vol.UsageData == nil || vol.UsageData.RefCount != 0 {
+			continue
+		}
+
+		orphans.Volumes = append(orphans.Volumes, OrphanVolume{
+			Name: vol.Name,
+			Size: vol.UsageData.Size,
+		})
+	}
+
+	// Images still referenced by a service definition or any container
+	referencedImages := make(map[string]bool)
+	for _, service := range project.Services {
+		if service.Image != "" {
+			referencedImages[normalizeImageTag(service.Image)] = true
+		}
+		if service.Build != nil && service.Image == "" {
+			referencedImages[normalizeImageTag(fmt.Sprintf("%s-%s", project.Name, service.Name))] = true
+		}
+	}
+
+	usedImageIDs := make(map[string]bool)
+	for _, cont := range usage.Containers {
+		usedImageIDs[cont.ImageID] = true
+	}
+
+	for _, image := range usage.Images {
+		if usedImageIDs[image.ID] || !isProjectImage(image, project.Name) {
+			continue
+		}
+
+		referenced := false
+		for _, tag := range image.RepoTags {
+			if referencedImages[normalizeImageTag(tag)] {
+				referenced = true
+				break
+			}
+		}
+		if referenced {
+			continue
+		}
+
+		orphans.Images = append(orphans.Images, OrphanImage{
+			ID:   image.ID,
+			Tags: image.RepoTags,
+			Size: image.Size,
+		})
+	}
+
+	sort.Slice(orphans.Volumes, func(i, j int) bool { return orphans.Volumes[i].Name < orphans.Volumes[j].Name })
+	sort.Slice(orphans.Images, func(i, j int) bool { return orphans.Images[i].ID < orphans.Images[j].ID })
+
+	return orphans, nil
+}
+
+// RemoveVolume removes a volume by name
+func (cm *ComposeManager) RemoveVolume(name string) error {
+	if err := cm.dockerClient.VolumeRemove(cm.ctx, name, false); err != nil {
+		return fmt.Errorf("failed to remove volume %s: %v", name, err)
+	}
+	return nil
+}
+
+// RemoveImage removes an image by ID, including all its tags
+func (cm *ComposeManager) RemoveImage(id string) error {
+	_, err := cm.dockerClient.ImageRemove(cm.ctx, id, dockertypes.ImageRemoveOptions{Force: true, PruneChildren: true})
+	if err != nil {
+		return fmt.Errorf("failed to remove image %s: %v", ShortID(id), err)
+	}
+	return nil
+}
+
+// isProjectImage reports whether an image was built by compose for the project
+func isProjectImage(image *dockertypes.ImageSummary, projectName string) bool {
+	if image.Labels["com.docker.compose.project"] == projectName {
+		return true
+	}
+
+	// Compose names built images <project>-<service> (or <project>_<service> in v1)
+	for _, tag := range image.RepoTags {
+		if strings.HasPrefix(tag, projectName+"-") || strings.HasPrefix(tag, projectName+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeImageTag adds the implicit latest tag so references can be compared
+func normalizeImageTag(image string) string {
+	lastSlash := strings.LastIndex(image, "/")
+	if !strings.Contains(image[lastSlash+1:], ":") && !strings.Contains(image, "@") {
+		return image + ":latest"
+	}
+	return image
+}
+
+// ShortID returns the 12 character form of a Docker object ID
+func ShortID(id string) string {
+	id = strings.TrimPrefix(id, "sha256:")
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
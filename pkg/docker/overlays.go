@@ -0,0 +1,168 @@
+package docker
+
+import (
+	"bufio"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// overlayNamePattern restricts overlay names to safe file names
+var overlayNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// overlayExtensions are the file extensions overlays are looked up with, in order
+var overlayExtensions = []string{".yaml", ".yml"}
+
+// Overlay is a reusable partial compose file stored in the overlays library
+type Overlay struct {
+	Name string
+	Path string
+	// Description is the first comment line of the file
+	Description string
+}
+
+// OverlaysDir returns the directory the overlays library is stored in
+func OverlaysDir() (string, error) {
+	return utils.ConfigDir("overlays")
+}
+
+// FindOverlay returns the overlay stored under a name
+func FindOverlay(name string) (Overlay, error) {
+	if !overlayNamePattern.MatchString(name) {
+		return Overlay{}, fmt.Errorf("invalid overlay name %q, use letters, digits, '.', '_' and '-'", name)
+	}
+	dir, err := OverlaysDir()
+	if err != nil {
+		return Overlay{}, err
+	}
+
+	for _, extension := range overlayExtensions {
+		path := filepath.Join(dir, name+extension)
+		if _, err := os.Stat(path); err == nil {
+			return Overlay{Name: name, Path: path, Description: overlayDescription(path)}, nil
+		}
+	}
+	return Overlay{}, fmt.Errorf("overlay %s not found in %s", name, dir)
+}
+
+// ListOverlays returns the overlays of the library sorted by name
+func ListOverlays() ([]Overlay, error) {
+	dir, err := OverlaysDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overlays directory: %v", err)
+	}
+
+	var overlays []Overlay
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		extension := filepath.Ext(entry.Name())
+		name := strings.TrimSuffix(entry.Name(), extension)
+		if !contains(overlayExtensions, extension) || !overlayNamePattern.MatchString(name) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		overlays = append(overlays, Overlay{Name: name, Path: path, Description: overlayDescription(path)})
+	}
+
+	sort.Slice(overlays, func(i, j int) bool { return overlays[i].Name < overlays[j].Name })
+	return overlays, nil
+}
+
+// overlayDescription returns the text of the first comment line of an overlay file
+func overlayDescription(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if description, ok := strings.CutPrefix(line, "#"); ok {
+			return strings.TrimSpace(description)
+		}
+		return ""
+	}
+	return ""
+}
+
+// ValidateOverlays checks that the overlays merge cleanly over a project. An overlay may only
+// modify services of the project, a service it would add is most likely a snippet written for
+// another project.
+func (cm *ComposeManager) ValidateOverlays(projectDir string, overlays []Overlay) error {
+	base, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, service := range base.AllServices() {
+		names = append(names, service.Name)
+	}
+
+	var paths []string
+	for _, overlay := range overlays {
+		services, err := overlayServices(overlay.Path)
+		if err != nil {
+			return fmt.Errorf("overlay %s: %v", overlay.Name, err)
+		}
+
+		var unknown []string
+		for _, service := range services {
+			if !contains(names, service) {
+				unknown = append(unknown, service)
+			}
+		}
+		if len(unknown) > 0 {
+			return fmt.Errorf("overlay %s modifies service(s) not in project %s: %s", overlay.Name, base.Name, strings.Join(unknown, ", "))
+		}
+		paths = append(paths, overlay.Path)
+	}
+
+	if _, err := cm.loadProjectFiles(projectDir, paths); err != nil {
+		return fmt.Errorf("overlays do not merge cleanly: %v", err)
+	}
+	return nil
+}
+
+// overlayServices returns the names of the services an overlay file declares
+func overlayServices(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overlay: %v", err)
+	}
+
+	var content struct {
+		Services map[string]any `yaml:"services"`
+	}
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %v", err)
+	}
+
+	var services []string
+	for name := range content.Services {
+		services = append(services, name)
+	}
+	sort.Strings(services)
+	return services, nil
+}
@@ -0,0 +1,173 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// MountPermission describes a bind mount the service's container user is likely unable to access
+type MountPermission struct {
+	Service  string
+	Path     string
+	Mode     fs.FileMode
+	UID      int
+	GID      int
+	User     string // container user as configured, "root" when the image default is used
+	ReadOnly bool
+	Problem  string
+	FixUID   int // owner to chown the path to, or -1 when only chmod is suggested
+	FixGID   int
+	FixMode  fs.FileMode // mode the path needs, applied after the chown if any
+}
+
+// CheckMountPermissions inspects the bind mount sources of each service and reports those whose
+// owner and mode would deny the container's user access. Services running as root, or as a user
+// name that cannot be mapped to a uid, are skipped.
+func (cm *ComposeManager) CheckMountPermissions(project *types.Project) ([]MountPermission, error) {
+	var problems []MountPermission
+
+	for _, service := range project.Services {
+		user := service.User
+		if user == "" {
+			user = cm.imageUser(service.Image)
+		}
+		uid, gid, ok := parseContainerUser(user)
+		if !ok || uid == 0 {
+			continue
+		}
+
+		for _, volume := range service.Volumes {
+			if volume.Type != types.VolumeTypeBind || volume.Source == "" {
+				continue
+			}
+
+			source := projectPath(project, volume.Source)
+			info, err := os.Stat(source)
+			if err != nil {
+				continue
+			}
+			ownerUID, ownerGID, ok := utils.FileOwner(info)
+			if !ok {
+				return nil, fmt.Errorf("file ownership is not available on this platform")
+			}
+
+			needed := neededPermission(info.IsDir(), volume.ReadOnly)
+			if permitted(info.Mode(), ownerUID, ownerGID, uid, gid)&needed == needed {
+				continue
+			}
+
+			problem := MountPermission{
+				Service:  service.Name,
+				Path:     source,
+				Mode:     info.Mode(),
+				UID:      ownerUID,
+				GID:      ownerGID,
+				User:     user,
+				ReadOnly: volume.ReadOnly,
+				FixUID:   -1,
+			}
+			// Grant the needed bits to the class the container user falls in. A user that is
+			// neither owner nor group gets the path chowned rather than opened to everyone.
+			switch {
+			case ownerUID == uid:
+				problem.FixMode = info.Mode().Perm() | needed<<6
+			case ownerGID == gid:
+				problem.FixMode = info.Mode().Perm() | needed<<3
+			default:
+				problem.FixUID = uid
+				problem.FixGID = gid
+				problem.FixMode = info.Mode().Perm() | needed<<6
+			}
+			access := "write to"
+			if volume.ReadOnly {
+				access = "read"
+			}
+			problem.Problem = fmt.Sprintf("uid %d cannot %s a path owned by %d:%d with mode %s", uid, access, ownerUID, ownerGID, info.Mode().Perm())
+			problems = append(problems, problem)
+		}
+	}
+
+	return problems, nil
+}
+
+// FixMountPermission changes the owner and mode of the mount source as suggested
+func FixMountPermission(problem MountPermission) error {
+	if problem.FixUID >= 0 {
+		if err := os.Chown(problem.Path, problem.FixUID, problem.FixGID); err != nil {
+			return fmt.Errorf("failed to change owner of %s: %v", problem.Path, err)
+		}
+	}
+	if problem.FixMode == problem.Mode.Perm() {
+		return nil
+	}
+
+	if err := os.Chmod(problem.Path, problem.FixMode); err != nil {
+		return fmt.Errorf("failed to change mode of %s: %v", problem.Path, err)
+	}
+	return nil
+}
+
+// imageUser returns the default user of a local image, "root" when unset or unknown
+func (cm *ComposeManager) imageUser(image string) string {
+	if image == "" {
+		return "root"
+	}
+	inspect, _, err := cm.dockerClient.ImageInspectWithRaw(cm.ctx, image)
+	if err != nil || inspect.Config == nil || inspect.Config.User == "" {
+		return "root"
+	}
+	return inspect.Config.User
+}
+
+// parseContainerUser returns the uid and gid of a user:group spec. Only numeric users and root
+// can be resolved without reading the image's passwd file. The gid defaults to the uid.
+func parseContainerUser(user string) (int, int, bool) {
+	name, group, hasGroup := strings.Cut(user, ":")
+	if name == "root" || name == "" {
+		return 0, 0, true
+	}
+
+	uid, err := strconv.Atoi(name)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	gid := uid
+	if hasGroup {
+		if gid, err = strconv.Atoi(group); err != nil {
+			gid = uid
+		}
+	}
+	return uid, gid, true
+}
+
+// neededPermission returns the rwx bits (as 0o7) the container needs on the mount source
+func neededPermission(isDir, readOnly bool) fs.FileMode {
+	needed := fs.FileMode(0o4)
+	if !readOnly {
+		needed |= 0o2
+	}
+	if isDir {
+		needed |= 0o1
+	}
+	return needed
+}
+
+// permitted returns the rwx bits (as 0o7) that apply to uid:gid on a file
+func permitted(mode fs.FileMode, ownerUID, ownerGID, uid, gid int) fs.FileMode {
+	perm := mode.Perm()
+	switch {
+	case ownerUID == uid:
+		return (perm >> 6) & 0o7
+	case ownerGID == gid:
+		return (perm >> 3) & 0o7
+	default:
+		return perm & 0o7
+	}
+}
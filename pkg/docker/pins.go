@@ -0,0 +1,133 @@
+package docker
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+)
+
+// Pin states reported by VerifyPins
+const (
+	PinMatch      = "match"
+	PinDrift      = "drift"
+	PinNotRunning = "not running"
+	PinUnpinned   = "unpinned"
+)
+
+// PinCheck represents the comparison of a service's running image against its pin
+type PinCheck struct {
+	Service string
+	Pinned  string
+	Running string
+	Status  string
+}
+
+// GetRunningImages returns the image each running service of the project was created from, as a
+// repository digest such as postgres@sha256:..., which stays valid on other hosts and after a
+// re-pull. Images that were never pushed or pulled have no digest and keep their local ID.
+func (cm *ComposeManager) GetRunningImages(projectDir string) (map[string]string, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	images := make(map[string]string)
+	resolved := make(map[string]string)
+	for _, cont := range containers {
+		service := cont.Labels["com.docker.compose.service"]
+		if cont.State != "running" || service == "" {
+			continue
+		}
+		// Replicas share their image, the first one is representative
+		if _, ok := images[service]; ok {
+			continue
+		}
+		if _, ok := resolved[cont.ImageID]; !ok {
+			resolved[cont.ImageID] = cm.imageDigest(cont.Image, cont.ImageID)
+		}
+		images[service] = resolved[cont.ImageID]
+	}
+
+	return images, nil
+}
+
+// imageDigest returns the repository digest of a local image, preferring the repository the
+// container was created from, or the image ID when the image has none
+func (cm *ComposeManager) imageDigest(image, imageID string) string {
+	inspect, _, err := cm.dockerClient.ImageInspectWithRaw(cm.ctx, imageID)
+	if err != nil || len(inspect.RepoDigests) == 0 {
+		return imageID
+	}
+
+	repository := normalizeRepository(image)
+	for _, digest := range inspect.RepoDigests {
+		if name, _, _ := strings.Cut(digest, "@"); normalizeRepository(name) == repository {
+			return digest
+		}
+	}
+	return inspect.RepoDigests[0]
+}
+
+// normalizeRepository strips the tag, digest and implicit Docker Hub prefixes of an image reference
+func normalizeRepository(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	image = strings.TrimPrefix(image, "docker.io/")
+	return strings.TrimPrefix(image, "library/")
+}
+
+// PinProject records the given service images as the pins of a project and saves projects.json
+func PinProject(projectName string, images map[string]string) error {
+	if _, ok := Projects[projectName]; !ok {
+		return fmt.Errorf("project %s not found", projectName)
+	}
+
+	settings := ProjectsSettings[projectName]
+	settings.Pins = maps.Clone(images)
+	ProjectsSettings[projectName] = settings
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save pins: %v", err)
+	}
+	return nil
+}
+
+// VerifyPins compares the running images of a project against its pins
+func VerifyPins(pins, running map[string]string) []PinCheck {
+	services := make(map[string]bool)
+	for service := range pins {
+		services[service] = true
+	}
+	for service := range running {
+		services[service] = true
+	}
+
+	var checks []PinCheck
+	for service := range services {
+		check := PinCheck{Service: service, Pinned: pins[service], Running: running[service]}
+		switch {
+		case check.Pinned == "":
+			check.Status = PinUnpinned
+		case check.Running == "":
+			check.Status = PinNotRunning
+		case check.Pinned == check.Running:
+			check.Status = PinMatch
+		default:
+			check.Status = PinDrift
+		}
+		checks = append(checks, check)
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Service < checks[j].Service
+	})
+	return checks
+}
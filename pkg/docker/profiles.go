@@ -0,0 +1,42 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// ProjectProfiles maps each profile declared by the services of a project to its services, sorted
+func ProjectProfiles(project *types.Project) map[string][]string {
+	profiles := make(map[string][]string)
+	for _, service := range project.AllServices() {
+		for _, profile := range service.Profiles {
+			profiles[profile] = append(profiles[profile], service.Name)
+		}
+	}
+	for _, services := range profiles {
+		sort.Strings(services)
+	}
+	return profiles
+}
+
+// SaveProfiles remembers the profiles selected for a project in projects.json
+func SaveProfiles(projectName string, profiles []string) error {
+	if _, ok := Projects[projectName]; !ok {
+		return fmt.Errorf("project %s not found", projectName)
+	}
+
+	settings := ProjectsSettings[projectName]
+	settings.Profiles = profiles
+	if settings.IsEmpty() {
+		delete(ProjectsSettings, projectName)
+	} else {
+		ProjectsSettings[projectName] = settings
+	}
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save profiles: %v", err)
+	}
+	return nil
+}
@@ -1,16 +1,86 @@
 package docker
 
 import (
+	"dockyard/pkg/utils"
 	"fmt"
-	"github.com/AlecAivazis/survey/v2"
+	"maps"
+	"os"
+	"slices"
 	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
 )
 
 var Projects = make(map[string]string)
 
+// ProjectsSettings holds the optional settings of projects that define more than a path
+var ProjectsSettings = make(map[string]ProjectSettings)
+
+// ProjectSettings represents optional per-project configuration stored in projects.json
+type ProjectSettings struct {
+	// Probes maps a service name to a readiness probe target (HTTP URL or TCP address)
+	Probes map[string]string `json:"probes,omitempty"`
+	// Pins maps a service name to the image it was pinned to with `dockyard pin`, as a repository
+	// digest, or the local image ID for images that have none
+	Pins map[string]string `json:"pins,omitempty"`
+	// Scale maps a service name to the number of replicas started by default
+	Scale map[string]int `json:"scale,omitempty"`
+	// Aliases are short names that resolve to the project
+	Aliases []string `json:"aliases,omitempty"`
+	// Timeout bounds each docker command run for the project, as a duration such as "10m"
+	Timeout string `json:"timeout,omitempty"`
+	// PendingLimits maps a service name to resource limits applied the next time it starts
+	PendingLimits map[string]ServiceLimits `json:"pending_limits,omitempty"`
+	// Profiles are the compose profiles last selected with `dockyard profiles --save`
+	Profiles []string `json:"profiles,omitempty"`
+	// Note describes the purpose of the project, set with `dockyard note`
+	Note string `json:"note,omitempty"`
+}
+
+// IsEmpty reports whether no optional settings are defined
+func (s ProjectSettings) IsEmpty() bool {
+	return len(s.Probes) == 0 && len(s.Pins) == 0 && len(s.Scale) == 0 && len(s.Aliases) == 0 && s.Timeout == "" &&
+		len(s.PendingLimits) == 0 && len(s.Profiles) == 0 && s.Note == ""
+}
+
+// OperationTimeout parses the configured operation timeout, 0 when none is set
+func (s ProjectSettings) OperationTimeout() (time.Duration, error) {
+	if s.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(s.Timeout)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid timeout %q, use a duration such as 10m", s.Timeout)
+	}
+	return timeout, nil
+}
+
+// clone returns a deep copy of the settings. Aliases are not copied since they must stay unique,
+// nor the note, which describes the original project. Pending limits are, so the copy starts
+// with the limits the original would have.
+func (s ProjectSettings) clone() ProjectSettings {
+	return ProjectSettings{
+		Probes:        maps.Clone(s.Probes),
+		Pins:          maps.Clone(s.Pins),
+		Scale:         maps.Clone(s.Scale),
+		Timeout:       s.Timeout,
+		Profiles:      slices.Clone(s.Profiles),
+		PendingLimits: maps.Clone(s.PendingLimits),
+	}
+}
+
+// projectEntry is the extended projects.json form of a project
+type projectEntry struct {
+	Path string `json:"path"`
+	ProjectSettings
+}
+
 func init() {
 	if err := LoadProjectsFromFile("projects.json"); err != nil {
 		Projects = make(map[string]string)
+		ProjectsSettings = make(map[string]ProjectSettings)
 	}
 }
 
@@ -23,6 +93,56 @@ func GetSortedProjectNames() []string {
 	return projectNames
 }
 
+// SuggestProjectNames returns the registered projects closest to name by edit distance.
+// Only names within a typo-sized distance are returned, so the result is empty for names
+// that do not resemble any project.
+func SuggestProjectNames(name string) []string {
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	bestDistance := maxDistance + 1
+	var suggestions []string
+	for _, projectName := range GetSortedProjectNames() {
+		distance := levenshtein(strings.ToLower(name), strings.ToLower(projectName))
+		switch {
+		case distance < bestDistance:
+			bestDistance = distance
+			suggestions = []string{projectName}
+		case distance == bestDistance:
+			suggestions = append(suggestions, projectName)
+		}
+	}
+
+	return suggestions
+}
+
+// levenshtein computes the edit distance between two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
 func AddProject() error {
 	var projectName string
 	err := survey.AskOne(&survey.Input{Message: "Enter the project name:"}, &projectName)
@@ -108,8 +228,9 @@ func RemoveProject() error {
 	var projectToRemove string
 
 	removePrompt := &survey.Select{
-		Message: "Select the project you'd like to remove:",
-		Options: projectNames,
+		Message:     "Select the project you'd like to remove:",
+		Options:     projectNames,
+		Description: ProjectNoteDescription,
 	}
 
 	err := survey.AskOne(removePrompt, &projectToRemove)
@@ -130,6 +251,7 @@ func RemoveProject() error {
 
 	if confirm == "Yes" {
 		delete(Projects, projectToRemove)
+		delete(ProjectsSettings, projectToRemove)
 
 		if err := SaveProjectsToFile("projects.json"); err != nil {
 			return fmt.Errorf("failed to save projects after removal: %v", err)
@@ -142,3 +264,171 @@ func RemoveProject() error {
 
 	return nil
 }
+
+// CloneProject registers a copy of an existing project's configuration under a new name.
+// When newPath is empty the source path is reused. With copyFiles the source directory is
+// copied to newPath before registering it.
+func CloneProject(sourceName, newName, newPath string, copyFiles bool) error {
+	sourcePath, ok := Projects[sourceName]
+	if !ok {
+		return fmt.Errorf("unknown project: %s", sourceName)
+	}
+
+	if _, exists := Projects[newName]; exists {
+		return fmt.Errorf("project '%s' already exists", newName)
+	}
+
+	if copyFiles && newPath == "" {
+		return fmt.Errorf("--copy-files requires --path to point at the destination directory")
+	}
+
+	if newPath == "" {
+		newPath = sourcePath
+	}
+
+	resolvedPath, err := utils.ResolveHomeDir(newPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve home directory: %v", err)
+	}
+
+	if copyFiles {
+		sourceDir, err := utils.ResolveHomeDir(sourcePath)
+		if err != nil {
+			return fmt.Errorf("failed to resolve home directory: %v", err)
+		}
+
+		fmt.Printf("📁 Copying %s to %s...\n", sourceDir, resolvedPath)
+		if err := CopyDirectory(sourceDir, resolvedPath); err != nil {
+			return fmt.Errorf("failed to copy project files: %v", err)
+		}
+	} else if info, err := os.Stat(resolvedPath); err != nil || !info.IsDir() {
+		return fmt.Errorf("project path does not exist: %s", resolvedPath)
+	}
+
+	Projects[newName] = newPath
+	if settings, ok := ProjectsSettings[sourceName]; ok {
+		ProjectsSettings[newName] = settings.clone()
+	}
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save projects: %v", err)
+	}
+
+	return nil
+}
+
+// ResolveAlias returns the project an alias points to. Project names take precedence, so an
+// alias equal to a project name is never resolved.
+func ResolveAlias(alias string) (string, bool) {
+	if _, ok := Projects[alias]; ok {
+		return "", false
+	}
+	for projectName, settings := range ProjectsSettings {
+		if slices.Contains(settings.Aliases, alias) {
+			return projectName, true
+		}
+	}
+	return "", false
+}
+
+// SetAlias adds an alias to a project and saves projects.json
+func SetAlias(alias, projectName string) error {
+	if _, ok := Projects[projectName]; !ok {
+		return fmt.Errorf("project %s not found", projectName)
+	}
+	if alias == "" || strings.ContainsAny(alias, " \t") {
+		return fmt.Errorf("invalid alias %q", alias)
+	}
+	if _, ok := Projects[alias]; ok {
+		return fmt.Errorf("alias %s is already the name of a project", alias)
+	}
+	if owner, ok := ResolveAlias(alias); ok {
+		if owner == projectName {
+			return nil
+		}
+		return fmt.Errorf("alias %s already points to project %s", alias, owner)
+	}
+
+	settings := ProjectsSettings[projectName]
+	settings.Aliases = append(settings.Aliases, alias)
+	sort.Strings(settings.Aliases)
+	ProjectsSettings[projectName] = settings
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save alias: %v", err)
+	}
+	return nil
+}
+
+// RemoveAlias deletes an alias and saves projects.json, returning the project it pointed to
+func RemoveAlias(alias string) (string, error) {
+	projectName, ok := ResolveAlias(alias)
+	if !ok {
+		return "", fmt.Errorf("alias %s not found", alias)
+	}
+
+	settings := ProjectsSettings[projectName]
+	settings.Aliases = slices.DeleteFunc(settings.Aliases, func(a string) bool { return a == alias })
+	if settings.IsEmpty() {
+		delete(ProjectsSettings, projectName)
+	} else {
+		ProjectsSettings[projectName] = settings
+	}
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return "", fmt.Errorf("failed to remove alias: %v", err)
+	}
+	return projectName, nil
+}
+
+// SetNote sets the note of a project and saves projects.json. An empty note removes it.
+func SetNote(projectName, note string) error {
+	if _, ok := Projects[projectName]; !ok {
+		return fmt.Errorf("project %s not found", projectName)
+	}
+
+	settings := ProjectsSettings[projectName]
+	settings.Note = strings.TrimSpace(note)
+	if settings.IsEmpty() {
+		delete(ProjectsSettings, projectName)
+	} else {
+		ProjectsSettings[projectName] = settings
+	}
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save note: %v", err)
+	}
+	return nil
+}
+
+// SaveScaleDefault persists the default replica count of a service in projects.json
+func SaveScaleDefault(projectName, service string, replicas int) error {
+	if _, ok := Projects[projectName]; !ok {
+		return fmt.Errorf("project %s not found", projectName)
+	}
+
+	settings := ProjectsSettings[projectName]
+	if settings.Scale == nil {
+		settings.Scale = make(map[string]int)
+	}
+	settings.Scale[service] = replicas
+	ProjectsSettings[projectName] = settings
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save scale default: %v", err)
+	}
+	return nil
+}
+
+// SetProjectPath changes the directory of a registered project in projects.json, keeping its settings
+func SetProjectPath(projectName, path string) error {
+	if _, ok := Projects[projectName]; !ok {
+		return fmt.Errorf("project %s not found", projectName)
+	}
+
+	Projects[projectName] = path
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save project path: %v", err)
+	}
+	return nil
+}
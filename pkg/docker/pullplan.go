@@ -0,0 +1,96 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/client"
+)
+
+// PlannedImage is an image of a project with what a pull would do for it
+type PlannedImage struct {
+	Image    string
+	Registry string
+	Services []string
+	// Build reports an image compose builds instead of pulling
+	Build   bool
+	Present bool
+	// Private and Authenticated are set as in RegistryImages
+	Private       bool
+	Authenticated bool
+}
+
+// NeedsPull reports whether a pull would fetch the image
+func (p PlannedImage) NeedsPull() bool {
+	return !p.Build && !p.Present
+}
+
+// PlanPull lists the images reported by docker compose config --images, sorted by name, with
+// whether each one is present locally, built, or pulled from which registry. Nothing is pulled.
+func (cm *ComposeManager) PlanPull(projectDir string, authenticated map[string]bool) ([]PlannedImage, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cm.ensureDockerRunning(); err != nil {
+		return nil, fmt.Errorf("docker is not accessible: %v", err)
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := composeOutput(projectDir, composeFilePath, "config", "--images")
+	if err != nil {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(output))
+	}
+
+	// Match the listed images back to the services using them
+	services := make(map[string][]string)
+	built := make(map[string]bool)
+	for _, service := range project.Services {
+		image := service.Image
+		if image == "" && service.Build != nil {
+			image = fmt.Sprintf("%s-%s", project.Name, service.Name)
+		}
+		services[image] = append(services[image], service.Name)
+		if service.Build != nil {
+			built[image] = true
+		}
+	}
+
+	var plan []PlannedImage
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		image := strings.TrimSpace(line)
+		if image == "" || seen[image] {
+			continue
+		}
+		seen[image] = true
+
+		planned := PlannedImage{
+			Image:    image,
+			Registry: ImageRegistry(image),
+			Services: services[image],
+			Build:    built[image],
+		}
+		planned.Private = planned.Registry != DefaultRegistry
+		planned.Authenticated = authenticated[planned.Registry]
+
+		_, _, err := cm.dockerClient.ImageInspectWithRaw(cm.ctx, image)
+		switch {
+		case err == nil:
+			planned.Present = true
+		case !client.IsErrNotFound(err):
+			return nil, fmt.Errorf("failed to inspect image %s: %v", image, err)
+		}
+		plan = append(plan, planned)
+	}
+
+	sort.Slice(plan, func(i, j int) bool { return plan[i].Image < plan[j].Image })
+	return plan, nil
+}
@@ -0,0 +1,157 @@
+package docker
+
+import (
+	"sort"
+
+	"github.com/compose-spec/compose-go/types"
+	dockertypes "github.com/docker/docker/api/types"
+)
+
+// Service readiness states reported by GetServiceReadiness
+const (
+	ReadinessWaiting   = "waiting"
+	ReadinessStarting  = "starting"
+	ReadinessHealthy   = "healthy"
+	ReadinessRunning   = "running"
+	ReadinessUnhealthy = "unhealthy"
+	ReadinessExited    = "exited"
+	ReadinessCompleted = "completed"
+)
+
+// ServiceNode represents a service in the depends_on graph of a project
+type ServiceNode struct {
+	Service   string
+	DependsOn []string
+	Level     int // 0 for services without dependencies, otherwise one more than the deepest dependency
+}
+
+// IsReadyState reports whether a readiness state means the service is up
+func IsReadyState(state string) bool {
+	return state == ReadinessHealthy || state == ReadinessRunning || state == ReadinessCompleted
+}
+
+// IsFailedState reports whether a readiness state means the service will not become ready
+func IsFailedState(state string) bool {
+	return state == ReadinessUnhealthy || state == ReadinessExited
+}
+
+// DependencyGraph returns the services of a project in start order, each with its dependencies
+func DependencyGraph(project *types.Project) []ServiceNode {
+	dependencies := make(map[string][]string, len(project.Services))
+	for _, service := range project.Services {
+		var deps []string
+		for dep := range service.DependsOn {
+			deps = append(deps, dep)
+		}
+		sort.Strings(deps)
+		dependencies[service.Name] = deps
+	}
+
+	levels := make(map[string]int, len(dependencies))
+	var levelOf func(service string, visiting map[string]bool) int
+	levelOf = func(service string, visiting map[string]bool) int {
+		if level, ok := levels[service]; ok {
+			return level
+		}
+		// Compose rejects cycles, but don't recurse forever if one slips through
+		if visiting[service] {
+			return 0
+		}
+		visiting[service] = true
+
+		level := 0
+		for _, dep := range dependencies[service] {
+			level = max(level, levelOf(dep, visiting)+1)
+		}
+		levels[service] = level
+		return level
+	}
+
+	nodes := make([]ServiceNode, 0, len(dependencies))
+	for service, deps := range dependencies {
+		nodes = append(nodes, ServiceNode{
+			Service:   service,
+			DependsOn: deps,
+			Level:     levelOf(service, make(map[string]bool)),
+		})
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Level != nodes[j].Level {
+			return nodes[i].Level < nodes[j].Level
+		}
+		return nodes[i].Service < nodes[j].Service
+	})
+	return nodes
+}
+
+// GetServiceReadiness returns the readiness state of each service of the project, using the
+// healthcheck status when the container defines one and the container state otherwise
+func (cm *ComposeManager) GetServiceReadiness(project *types.Project) (map[string]string, error) {
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]string, len(project.Services))
+	for _, service := range project.Services {
+		states[service.Name] = ReadinessWaiting
+	}
+
+	for _, cont := range containers {
+		service := cont.Labels["com.docker.compose.service"]
+		if _, ok := states[service]; !ok {
+			continue
+		}
+
+		state := cm.containerReadiness(cont)
+		// With replicas, report the least ready one
+		if states[service] == ReadinessWaiting || readinessRank(state) < readinessRank(states[service]) {
+			states[service] = state
+		}
+	}
+
+	return states, nil
+}
+
+// containerReadiness returns the readiness state of a single container
+func (cm *ComposeManager) containerReadiness(cont dockertypes.Container) string {
+	state := ReadinessStarting
+	switch cont.State {
+	case "running":
+		state = ReadinessRunning
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err == nil && inspect.State != nil && inspect.State.Health != nil {
+			switch inspect.State.Health.Status {
+			case "healthy":
+				state = ReadinessHealthy
+			case "unhealthy":
+				state = ReadinessUnhealthy
+			default:
+				state = ReadinessStarting
+			}
+		}
+	case "exited", "dead":
+		state = ReadinessExited
+		// One-shot services such as migrations are done once they exit successfully
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err == nil && inspect.State != nil && inspect.State.ExitCode == 0 && cont.State == "exited" {
+			state = ReadinessCompleted
+		}
+	}
+	return state
+}
+
+// readinessRank orders states from failed to ready
+func readinessRank(state string) int {
+	switch state {
+	case ReadinessUnhealthy, ReadinessExited:
+		return 0
+	case ReadinessWaiting:
+		return 1
+	case ReadinessStarting:
+		return 2
+	default:
+		return 3
+	}
+}
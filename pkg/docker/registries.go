@@ -0,0 +1,195 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// dockerHubAuthKey is the key Docker Hub credentials are stored under in the docker config
+const dockerHubAuthKey = "https://index.docker.io/v1/"
+
+// RegistryImages are the images a project pulls from one registry
+type RegistryImages struct {
+	Registry      string
+	Images        map[string]string // service name to image reference
+	Private       bool              // images may need credentials to pull
+	Authenticated bool              // docker has credentials stored for the registry
+}
+
+// dockerConfig is the part of ~/.docker/config.json describing stored credentials
+type dockerConfig struct {
+	Auths       map[string]json.RawMessage `json:"auths"`
+	CredsStore  string                     `json:"credsStore"`
+	CredHelpers map[string]string          `json:"credHelpers"`
+}
+
+// ProjectRegistries groups the images of a project's services by registry, sorted by registry.
+// Services that are only built locally are skipped.
+func ProjectRegistries(project *types.Project, authenticated map[string]bool) []RegistryImages {
+	byRegistry := make(map[string]*RegistryImages)
+	for _, service := range project.Services {
+		if service.Image == "" || service.Build != nil {
+			continue
+		}
+
+		registry := ImageRegistry(service.Image)
+		images, ok := byRegistry[registry]
+		if !ok {
+			images = &RegistryImages{
+				Registry:      registry,
+				Images:        make(map[string]string),
+				Private:       registry != DefaultRegistry,
+				Authenticated: authenticated[registry],
+			}
+			byRegistry[registry] = images
+		}
+		images.Images[service.Name] = service.Image
+	}
+
+	registries := make([]RegistryImages, 0, len(byRegistry))
+	for _, images := range byRegistry {
+		registries = append(registries, *images)
+	}
+	sort.Slice(registries, func(i, j int) bool {
+		return registries[i].Registry < registries[j].Registry
+	})
+	return registries
+}
+
+// Sources of stored registry credentials
+const (
+	CredentialConfigFile = "config file"
+	CredentialHelper     = "credential helper"
+	CredentialStore      = "credential store"
+)
+
+// RegistryCredential tells where docker stores the credentials of a registry
+type RegistryCredential struct {
+	Registry string
+	// Server is the address the credentials are stored under, as given to docker login
+	Server string
+	Source string
+	// Helper is the credential helper or store holding the credentials
+	Helper string
+}
+
+// AuthenticatedRegistries returns the registries docker has stored credentials for, read from
+// the docker config and its credential store
+func AuthenticatedRegistries() (map[string]bool, error) {
+	credentials, err := StoredCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	authenticated := make(map[string]bool, len(credentials))
+	for _, credential := range credentials {
+		authenticated[credential.Registry] = true
+	}
+	return authenticated, nil
+}
+
+// StoredCredentials lists the registry credentials of the docker config, the ones held by its
+// credential helpers and by its credential store, sorted by registry
+func StoredCredentials() ([]RegistryCredential, error) {
+	path, err := DockerConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker config: %v", err)
+	}
+
+	var config dockerConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse docker config: %v", err)
+	}
+
+	var credentials []RegistryCredential
+	for server, raw := range config.Auths {
+		// With a credential store, docker leaves empty entries in auths
+		var entry struct {
+			Auth string `json:"auth"`
+		}
+		if json.Unmarshal(raw, &entry) != nil || entry.Auth == "" {
+			continue
+		}
+		credentials = append(credentials, RegistryCredential{Registry: registryHost(server), Server: server, Source: CredentialConfigFile})
+	}
+	for server, helper := range config.CredHelpers {
+		credentials = append(credentials, RegistryCredential{Registry: registryHost(server), Server: server, Source: CredentialHelper, Helper: helper})
+	}
+	if config.CredsStore != "" {
+		servers, err := credentialStoreServers(config.CredsStore)
+		if err != nil {
+			return nil, err
+		}
+		for _, server := range servers {
+			credentials = append(credentials, RegistryCredential{Registry: registryHost(server), Server: server, Source: CredentialStore, Helper: config.CredsStore})
+		}
+	}
+
+	sort.Slice(credentials, func(i, j int) bool {
+		if credentials[i].Registry != credentials[j].Registry {
+			return credentials[i].Registry < credentials[j].Registry
+		}
+		return credentials[i].Server < credentials[j].Server
+	})
+	return credentials, nil
+}
+
+// DockerConfigPath returns the path of the docker CLI configuration file
+func DockerConfigPath() (string, error) {
+	configDir := os.Getenv("DOCKER_CONFIG")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		configDir = filepath.Join(home, ".docker")
+	}
+	return filepath.Join(configDir, "config.json"), nil
+}
+
+// credentialStoreServers lists the servers a docker credential helper holds credentials for
+func credentialStoreServers(store string) ([]string, error) {
+	output, err := exec.Command("docker-credential-"+store, "list").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials of store %s: %v", store, err)
+	}
+
+	var credentials map[string]string
+	if err := json.Unmarshal(output, &credentials); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials of store %s: %v", store, err)
+	}
+
+	servers := make([]string, 0, len(credentials))
+	for server := range credentials {
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
+
+// registryHost reduces a credential server address to the registry host used in image references
+func registryHost(server string) string {
+	if server == dockerHubAuthKey {
+		return DefaultRegistry
+	}
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	host, _, _ := strings.Cut(server, "/")
+	if host == "index.docker.io" || host == "registry-1.docker.io" {
+		return DefaultRegistry
+	}
+	return host
+}
@@ -1,13 +1,19 @@
 package docker
 
 import (
+	"errors"
 	"fmt"
 	"github.com/AlecAivazis/survey/v2"
+	"gopkg.in/yaml.v3"
+	"os"
 	"os/exec"
 	"regexp"
 	"strings"
 )
 
+// ErrAuthRequired indicates that an operation failed because registry authentication is missing
+var ErrAuthRequired = errors.New("registry authentication required")
+
 // RegistryError represents different types of registry authentication errors
 type RegistryError struct {
 	Registry    string
@@ -16,6 +22,55 @@ type RegistryError struct {
 	Suggestions []string
 }
 
+// RegistryPattern is a user-defined registry error pattern loaded from registries.yaml
+type RegistryPattern struct {
+	Name        string   `yaml:"name"`
+	Pattern     string   `yaml:"pattern"`
+	Registry    string   `yaml:"registry"`
+	Suggestions []string `yaml:"suggestions"`
+
+	regex *regexp.Regexp
+}
+
+// RegistriesConfig is the content of registries.yaml
+type RegistriesConfig struct {
+	Patterns []RegistryPattern `yaml:"patterns"`
+}
+
+// customRegistryPatterns are checked before the built-in patterns
+var customRegistryPatterns []RegistryPattern
+
+// LoadRegistryPatterns loads custom registry error patterns from a YAML file. A missing file
+// is not an error. Every pattern must have a name and compile as a regular expression.
+func LoadRegistryPatterns(filename string) error {
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var registries RegistriesConfig
+	if err := yaml.Unmarshal(data, &registries); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", filename, err)
+	}
+
+	for i, pattern := range registries.Patterns {
+		if pattern.Name == "" {
+			return fmt.Errorf("%s: pattern #%d has no name", filename, i+1)
+		}
+		regex, err := regexp.Compile(pattern.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s: pattern %s is not a valid regular expression: %v", filename, pattern.Name, err)
+		}
+		registries.Patterns[i].regex = regex
+	}
+
+	customRegistryPatterns = registries.Patterns
+	return nil
+}
+
 // DetectRegistryError analyzes error output to identify registry authentication issues
 func DetectRegistryError(errorOutput string) *RegistryError {
 	// Common registry error patterns
@@ -39,6 +94,21 @@ func DetectRegistryError(errorOutput string) *RegistryError {
 		image = imageMatch[1]
 	}
 
+	// Custom patterns are more specific than the built-in ones, so they are checked first
+	for _, custom := range customRegistryPatterns {
+		if custom.regex.MatchString(errorOutput) {
+			if custom.Registry != "" {
+				registry = custom.Registry
+			}
+			return &RegistryError{
+				Registry:    registry,
+				ErrorType:   custom.Name,
+				Image:       image,
+				Suggestions: customRegistrySuggestions(custom, registry),
+			}
+		}
+	}
+
 	// Determine error type and provide specific suggestions
 	for errorType, pattern := range patterns {
 		if pattern.MatchString(errorOutput) {
@@ -86,6 +156,20 @@ func getRegistrySuggestions(errorType, registry string) []string {
 	}
 }
 
+// customRegistrySuggestions returns the suggestions of a custom pattern, falling back to the
+// generic ones. {registry} is replaced by the registry the error refers to.
+func customRegistrySuggestions(pattern RegistryPattern, registry string) []string {
+	if len(pattern.Suggestions) == 0 {
+		return getRegistrySuggestions(pattern.Name, registry)
+	}
+
+	suggestions := make([]string, len(pattern.Suggestions))
+	for i, suggestion := range pattern.Suggestions {
+		suggestions[i] = strings.ReplaceAll(suggestion, "{registry}", registry)
+	}
+	return suggestions
+}
+
 // getRegistryURL returns the full registry URL for login
 func getRegistryURL(registry string) string {
 	if strings.Contains(registry, "gitlab.com") {
@@ -149,11 +233,11 @@ func HandleRegistryError(regError *RegistryError, errorOutput string) error {
 		return showDetailedGuide(regError)
 	case "Skip this project for now":
 		fmt.Println("⏭️  Skipping this project. You can try again after authentication.")
-		return fmt.Errorf("registry authentication required - skipped")
+		return fmt.Errorf("%w - skipped", ErrAuthRequired)
 	case "Open registry documentation":
 		return openRegistryDocs(regError)
 	default:
-		return fmt.Errorf("registry authentication required")
+		return ErrAuthRequired
 	}
 }
 
@@ -190,7 +274,7 @@ func assistWithLogin(regError *RegistryError) error {
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		fmt.Printf("❌ Login failed: %s\n", string(output))
-		return fmt.Errorf("docker login failed: %v", err)
+		return fmt.Errorf("%w: docker login failed: %v", ErrAuthRequired, err)
 	}
 
 	fmt.Printf("✅ Successfully logged in to %s!\n", registryURL)
@@ -215,7 +299,7 @@ func showDetailedGuide(regError *RegistryError) error {
 		showGenericGuide(regError.Registry)
 	}
 
-	return fmt.Errorf("please follow the authentication steps above")
+	return fmt.Errorf("please follow the authentication steps above: %w", ErrAuthRequired)
 }
 
 // showGitLabGuide shows GitLab-specific authentication guide
@@ -305,7 +389,7 @@ func openRegistryDocs(regError *RegistryError) error {
 		url = "https://docs.docker.com/docker-hub/"
 	default:
 		fmt.Println("🌐 Please check your registry provider's documentation for authentication instructions.")
-		return fmt.Errorf("registry authentication required")
+		return ErrAuthRequired
 	}
 
 	fmt.Printf("🌐 Opening documentation: %s\n", url)
@@ -320,5 +404,18 @@ func openRegistryDocs(regError *RegistryError) error {
 		}
 	}
 
-	return fmt.Errorf("please follow the documentation and authenticate")
+	return fmt.Errorf("please follow the documentation and authenticate: %w", ErrAuthRequired)
+}
+
+// DefaultRegistry is the registry of image references without a registry host
+const DefaultRegistry = "docker.io"
+
+// ImageRegistry returns the registry host of an image reference. The first path component is a
+// registry when it contains a dot or a port, or is localhost, as in the docker reference grammar.
+func ImageRegistry(image string) string {
+	first, _, hasPath := strings.Cut(image, "/")
+	if hasPath && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		return first
+	}
+	return DefaultRegistry
 }
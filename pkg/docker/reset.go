@@ -0,0 +1,113 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"strings"
+)
+
+// ResetPlan lists what resetting a project destroys
+type ResetPlan struct {
+	Project    string
+	Containers []string
+	// Volumes are the named volumes that exist on the daemon, external volumes are left alone
+	Volumes []ProjectVolume
+	// Images are the images compose built under its default name. Pulled and custom-named images
+	// are kept, since other projects may use them.
+	Images     []LocalImage
+	BuildCache *BuildCacheReport
+	// HasBuild reports whether some services are built rather than pulled
+	HasBuild bool
+}
+
+// PlanReset collects the containers, volumes, images and build cache a reset of the project removes
+func (cm *ComposeManager) PlanReset(projectDir string) (*ResetPlan, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ResetPlan{Project: project.Name}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, container := range containers {
+		if len(container.Names) > 0 {
+			plan.Containers = append(plan.Containers, strings.TrimPrefix(container.Names[0], "/"))
+		}
+	}
+
+	volumes, err := cm.GetProjectVolumes(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, volume := range volumes {
+		if volume.Exists && !volume.External {
+			plan.Volumes = append(plan.Volumes, volume)
+		}
+	}
+
+	images, err := cm.CheckLocalImages(project)
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range images {
+		if image.Built {
+			plan.HasBuild = true
+		}
+		// Matches what `down --rmi local` removes
+		service, _ := project.GetService(image.Service)
+		if image.Present && image.Built && service.Image == "" {
+			plan.Images = append(plan.Images, image)
+		}
+	}
+
+	if plan.HasBuild {
+		report, err := cm.GetProjectBuildCache(projectDir)
+		if err != nil {
+			return nil, err
+		}
+		plan.BuildCache = report
+	}
+
+	return plan, nil
+}
+
+// ResetProject takes the project down with its volumes, orphans and built images, then prunes the
+// unused build cache found by the plan
+func (cm *ComposeManager) ResetProject(projectDir string, plan *ResetPlan) error {
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🧨 Resetting project: %s\n", plan.Project)
+	if err := cm.executeCommandWithErrorHandling(projectDir, resetArguments(composeFilePath)...); err != nil {
+		return err
+	}
+
+	if plan.BuildCache != nil {
+		reclaimed, err := cm.PruneBuildCache(plan.BuildCache.RecordIDs())
+		if err != nil {
+			return err
+		}
+		if reclaimed > 0 {
+			fmt.Printf("🧹 Reclaimed %s of build cache\n", FormatBytes(int64(reclaimed)))
+		}
+	}
+
+	fmt.Printf("✅ Successfully reset project: %s\n", plan.Project)
+	return nil
+}
+
+// resetArguments returns the docker compose down arguments that remove everything a project created.
+// Only the images compose built are removed, pulled images may be shared with other projects.
+func resetArguments(composeFilePath string) []string {
+	return append(downArguments(composeFilePath, true, false), "--rmi", "local", "--remove-orphans")
+}
@@ -0,0 +1,237 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/compose-spec/compose-go/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Origins of a resolved service field that is not written in the service itself
+const (
+	OriginDefault = "compose default"
+)
+
+// FieldOrigin tells where an inherited field of a service comes from
+type FieldOrigin struct {
+	Field  string
+	Source string // "&anchor", "extends <service>" or OriginDefault
+}
+
+// ServiceResolution lists the fields a service writes itself and those it inherits
+type ServiceResolution struct {
+	Service   string
+	Own       []string
+	Inherited []FieldOrigin
+}
+
+// rawService holds what a service declares in the compose file before anchors and extends are applied
+type rawService struct {
+	own     map[string]bool
+	anchors map[string][]string // anchor name to the keys it provides
+	extends string
+}
+
+// ResolvedConfigYAML returns the project configuration with anchors and extends expanded
+func ResolvedConfigYAML(project *types.Project) (string, error) {
+	data, err := project.MarshalYAML()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode resolved config: %v", err)
+	}
+	return string(data), nil
+}
+
+// ResolveInheritance compares each resolved service of a project with its declaration in the
+// compose file to report which fields come from YAML merge anchors or extends
+func ResolveInheritance(projectDir string, project *types.Project) ([]ServiceResolution, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := readRawServices(composeFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolutions []ServiceResolution
+	for _, service := range project.Services {
+		fields, err := resolvedFields(service)
+		if err != nil {
+			return nil, err
+		}
+
+		declared := raw[service.Name]
+		resolution := ServiceResolution{Service: service.Name}
+		for _, field := range fields {
+			if declared.own[field] {
+				resolution.Own = append(resolution.Own, field)
+				continue
+			}
+			resolution.Inherited = append(resolution.Inherited, FieldOrigin{Field: field, Source: fieldSource(raw, service.Name, field)})
+		}
+		resolutions = append(resolutions, resolution)
+	}
+
+	sort.Slice(resolutions, func(i, j int) bool {
+		return resolutions[i].Service < resolutions[j].Service
+	})
+	return resolutions, nil
+}
+
+// fieldSource finds the anchor or extends chain a field comes from. Fields that neither provides
+// were added by the compose loader.
+func fieldSource(raw map[string]rawService, service, field string) string {
+	for visited := map[string]bool{}; !visited[service]; {
+		visited[service] = true
+		declared, ok := raw[service]
+		if !ok {
+			break
+		}
+
+		for _, anchor := range sortedAnchorNames(declared.anchors) {
+			for _, key := range declared.anchors[anchor] {
+				if key == field {
+					return "&" + anchor
+				}
+			}
+		}
+
+		if declared.extends == "" {
+			break
+		}
+		base, ok := raw[declared.extends]
+		if !ok || base.own[field] {
+			// Services extended from another file cannot be inspected here
+			return "extends " + declared.extends
+		}
+		service = declared.extends
+	}
+	return OriginDefault
+}
+
+// resolvedFields returns the top-level fields of a resolved service
+func resolvedFields(service types.ServiceConfig) ([]string, error) {
+	data, err := yaml.Marshal(service)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(fields))
+	for name, value := range fields {
+		if name != "name" && value != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// readRawServices parses the service declarations of a compose file without resolving anchors
+func readRawServices(composeFilePath string) (map[string]rawService, error) {
+	content, err := os.ReadFile(composeFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compose file: %v", err)
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse compose file: %v", err)
+	}
+
+	services := make(map[string]rawService)
+	if len(document.Content) == 0 {
+		return services, nil
+	}
+
+	servicesNode := mappingValue(document.Content[0], "services")
+	if servicesNode == nil || servicesNode.Kind != yaml.MappingNode {
+		return services, nil
+	}
+
+	for i := 0; i+1 < len(servicesNode.Content); i += 2 {
+		name, node := servicesNode.Content[i].Value, servicesNode.Content[i+1]
+		services[name] = parseRawService(node)
+	}
+	return services, nil
+}
+
+func parseRawService(node *yaml.Node) rawService {
+	service := rawService{own: make(map[string]bool), anchors: make(map[string][]string)}
+	if node.Kind != yaml.MappingNode {
+		return service
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i].Value, node.Content[i+1]
+		switch key {
+		case "<<":
+			aliases := []*yaml.Node{value}
+			if value.Kind == yaml.SequenceNode {
+				aliases = value.Content
+			}
+			for _, alias := range aliases {
+				if alias.Kind == yaml.AliasNode && alias.Alias != nil {
+					service.anchors[alias.Value] = mappingKeys(alias.Alias)
+				}
+			}
+		case "extends":
+			service.own[key] = true
+			if value.Kind == yaml.ScalarNode {
+				service.extends = value.Value
+			} else if base := mappingValue(value, "service"); base != nil {
+				service.extends = base.Value
+				if file := mappingValue(value, "file"); file != nil {
+					service.extends = fmt.Sprintf("%s in %s", base.Value, file.Value)
+				}
+			}
+		default:
+			service.own[key] = true
+		}
+	}
+	return service
+}
+
+// mappingValue returns the value of key in a mapping node, following aliases
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func mappingKeys(node *yaml.Node) []string {
+	var keys []string
+	if node.Kind != yaml.MappingNode {
+		return keys
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys
+}
+
+func sortedAnchorNames(anchors map[string][]string) []string {
+	names := make([]string, 0, len(anchors))
+	for name := range anchors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
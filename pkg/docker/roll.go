@@ -0,0 +1,133 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+)
+
+// rollPollInterval is how often a new replica's readiness is checked during a roll
+const rollPollInterval = 2 * time.Second
+
+// RollService recreates the replicas of a service one at a time: a replica with the current
+// configuration is added, and once it is up (healthy when it has a healthcheck) an old replica is
+// removed. A service with a single replica or a fixed container name cannot run side by side with
+// its replacement, so it is recreated in place with a short downtime.
+func (cm *ComposeManager) RollService(projectDir, service string, timeout time.Duration) error {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return err
+	}
+	serviceConfig, err := project.GetService(service)
+	if err != nil {
+		return fmt.Errorf("service %s not found in project %s", service, project.Name)
+	}
+
+	replicas, err := cm.serviceContainers(project.Name, service)
+	if err != nil {
+		return err
+	}
+	if len(replicas) == 0 {
+		return fmt.Errorf("service %s has no running container", service)
+	}
+
+	if len(replicas) == 1 || serviceConfig.ContainerName != "" {
+		fmt.Printf("⚠️  Service %s runs a single container, it is recreated in place and briefly unavailable\n", service)
+		return cm.executeServiceCommand(projectDir, []string{"up", "-d", "--no-deps", "--force-recreate"}, []string{service})
+	}
+
+	known := make(map[string]bool, len(replicas))
+	for _, replica := range replicas {
+		known[replica.ID] = true
+	}
+
+	for i, old := range replicas {
+		oldName := strings.TrimPrefix(old.Names[0], "/")
+		fmt.Printf("🔄 Replica %d/%d: replacing %s\n", i+1, len(replicas), oldName)
+
+		if err := cm.ScaleService(projectDir, service, len(replicas)+1); err != nil {
+			return fmt.Errorf("failed to add a replica of %s: %v", service, err)
+		}
+
+		current, err := cm.serviceContainers(project.Name, service)
+		if err != nil {
+			return err
+		}
+		var replacement *dockertypes.Container
+		for j := range current {
+			if !known[current[j].ID] {
+				replacement = &current[j]
+				known[current[j].ID] = true
+				break
+			}
+		}
+		if replacement == nil {
+			return fmt.Errorf("no new replica of %s was created", service)
+		}
+		newName := strings.TrimPrefix(replacement.Names[0], "/")
+
+		fmt.Printf("⏳ Waiting for %s (timeout %s)...\n", newName, timeout)
+		if err := cm.waitContainerReady(replacement.ID, timeout); err != nil {
+			// Drop the broken replica so the service keeps its old replicas only
+			_ = cm.dockerClient.ContainerRemove(cm.ctx, replacement.ID, dockertypes.ContainerRemoveOptions{Force: true})
+			return fmt.Errorf("new replica %s %v, %d old replica(s) were kept", newName, err, len(replicas)-i)
+		}
+
+		if err := cm.dockerClient.ContainerStop(cm.ctx, old.ID, container.StopOptions{}); err != nil {
+			return fmt.Errorf("failed to stop %s: %v", oldName, err)
+		}
+		if err := cm.dockerClient.ContainerRemove(cm.ctx, old.ID, dockertypes.ContainerRemoveOptions{}); err != nil {
+			return fmt.Errorf("failed to remove %s: %v", oldName, err)
+		}
+		fmt.Printf("✅ Replaced %s with %s\n", oldName, newName)
+	}
+
+	return nil
+}
+
+// serviceContainers returns the running containers of a service sorted by name
+func (cm *ComposeManager) serviceContainers(projectName, service string) ([]dockertypes.Container, error) {
+	containers, err := cm.GetProjectContainers(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var running []dockertypes.Container
+	for _, cont := range containers {
+		if cont.Labels[LabelComposeService] == service && cont.State == "running" {
+			running = append(running, cont)
+		}
+	}
+	sort.Slice(running, func(i, j int) bool {
+		return running[i].Names[0] < running[j].Names[0]
+	})
+	return running, nil
+}
+
+// waitContainerReady waits until a container is running, and healthy when it has a healthcheck
+func (cm *ComposeManager) waitContainerReady(id string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, id)
+		if err != nil {
+			return fmt.Errorf("could not be inspected: %v", err)
+		}
+
+		// A replica that exited is not serving, even when it exited cleanly
+		state := cm.containerReadiness(dockertypes.Container{ID: id, State: inspect.State.Status})
+		switch state {
+		case ReadinessHealthy, ReadinessRunning:
+			return nil
+		case ReadinessUnhealthy, ReadinessExited, ReadinessCompleted:
+			return fmt.Errorf("is %s", state)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("was not ready after %s", timeout)
+		}
+		time.Sleep(rollPollInterval)
+	}
+}
@@ -0,0 +1,176 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// Default thresholds above which a container is considered runaway, in percent
+const (
+	DefaultRunawayCPU    = 90.0
+	DefaultRunawayMemory = 90.0
+)
+
+// RunawayContainer is a running compose container using more CPU or memory than allowed
+type RunawayContainer struct {
+	ID      string
+	Name    string
+	Project string // registered project name, or the compose project name when not registered
+	Service string
+	// Registered reports whether the container belongs to a project in projects.json
+	Registered bool
+	// CPUPercent is the usage as a percentage of the CPU limit, or of a single core without a limit
+	CPUPercent float64
+	// MemoryPercent is the usage as a percentage of the memory limit, or of the host memory without a limit
+	MemoryPercent float64
+	MemoryUsage   int64
+	ExceedsCPU    bool
+	ExceedsMemory bool
+}
+
+// FindRunawayContainers checks the stats of every running compose container and returns those whose
+// CPU or memory usage exceeds the thresholds, sorted by project and service
+func (cm *ComposeManager) FindRunawayContainers(cpuThreshold, memoryThreshold float64) ([]RunawayContainer, error) {
+	if err := cm.ensureDockerRunning(); err != nil {
+		return nil, fmt.Errorf("docker is not accessible: %v", err)
+	}
+
+	containers, usages, err := cm.sampleComposeContainers()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := cm.dockerClient.Info(cm.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system information: %v", err)
+	}
+
+	projectsByDir := registeredProjectDirs()
+	var runaways []RunawayContainer
+	for i, cont := range containers {
+		usage := usages[i]
+
+		runaway := RunawayContainer{
+			ID:          cont.ID,
+			Name:        usage.Name,
+			Service:     usage.Service,
+			CPUPercent:  usage.CPUPercent,
+			MemoryUsage: usage.MemoryUsage,
+		}
+		if usage.CPULimit > 0 {
+			runaway.CPUPercent = usage.CPULimitPercent()
+		}
+		if usage.MemoryLimit > 0 {
+			runaway.MemoryPercent = usage.MemoryPercent()
+		} else if info.MemTotal > 0 {
+			runaway.MemoryPercent = float64(usage.MemoryUsage) / float64(info.MemTotal) * 100
+		}
+		runaway.ExceedsCPU = runaway.CPUPercent > cpuThreshold
+		runaway.ExceedsMemory = runaway.MemoryPercent > memoryThreshold
+		if !runaway.ExceedsCPU && !runaway.ExceedsMemory {
+			continue
+		}
+
+		runaway.Project, runaway.Registered = containerProject(cont, projectsByDir)
+		runaways = append(runaways, runaway)
+	}
+
+	sort.Slice(runaways, func(i, j int) bool {
+		if runaways[i].Project != runaways[j].Project {
+			return runaways[i].Project < runaways[j].Project
+		}
+		return runaways[i].Name < runaways[j].Name
+	})
+	return runaways, nil
+}
+
+// sampleComposeContainers returns the running compose containers with their limits and a stats
+// snapshot, leaving out those that stop or disappear while being sampled
+func (cm *ComposeManager) sampleComposeContainers() ([]dockertypes.Container, []ResourceUsage, error) {
+	filterArgs := filters.NewArgs()
+	filterArgs.Add("label", LabelComposeProject)
+	containers, err := cm.dockerClient.ContainerList(cm.ctx, dockertypes.ContainerListOptions{Filters: filterArgs})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list containers: %v", err)
+	}
+
+	usages := make([]ResourceUsage, len(containers))
+	errs := make([]error, len(containers))
+
+	// A stats snapshot takes about a second to sample the CPU, so containers are sampled in parallel
+	var wg sync.WaitGroup
+	for i, cont := range containers {
+		wg.Add(1)
+		go func(i int, cont dockertypes.Container) {
+			defer wg.Done()
+			usages[i], errs[i] = cm.containerUsage(cont)
+		}(i, cont)
+	}
+	wg.Wait()
+
+	// Containers that stopped or were removed while being sampled have no stats and are skipped
+	var sampled []dockertypes.Container
+	var sampledUsages []ResourceUsage
+	for i, err := range errs {
+		if errors.Is(err, errContainerRemoved) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if usages[i].HasStats {
+			sampled = append(sampled, containers[i])
+			sampledUsages = append(sampledUsages, usages[i])
+		}
+	}
+	return sampled, sampledUsages, nil
+}
+
+// containerProject returns the registered project a container was started from, or its compose
+// project name when it does not belong to a registered project
+func containerProject(cont dockertypes.Container, projectsByDir map[string]string) (string, bool) {
+	if name, ok := projectsByDir[filepath.Clean(cont.Labels[LabelComposeWorkingDir])]; ok {
+		return name, true
+	}
+	return cont.Labels[LabelComposeProject], false
+}
+
+// registeredProjectDirs maps the directory of each local registered project to its name
+func registeredProjectDirs() map[string]string {
+	dirs := make(map[string]string, len(Projects))
+	for name, path := range Projects {
+		if utils.IsRemoteSource(path) {
+			continue
+		}
+		dir, err := utils.ResolveHomeDir(path)
+		if err != nil {
+			continue
+		}
+		dirs[filepath.Clean(dir)] = name
+	}
+	return dirs
+}
+
+// StopContainer stops a single container with the default grace period
+func (cm *ComposeManager) StopContainer(id string) error {
+	if err := cm.dockerClient.ContainerStop(cm.ctx, id, container.StopOptions{}); err != nil {
+		return fmt.Errorf("failed to stop container: %v", err)
+	}
+	return nil
+}
+
+// RestartContainer restarts a single container with the default grace period
+func (cm *ComposeManager) RestartContainer(id string) error {
+	if err := cm.dockerClient.ContainerRestart(cm.ctx, id, container.StopOptions{}); err != nil {
+		return fmt.Errorf("failed to restart container: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultScanDepth is how many directory levels below the root a scan descends
+const DefaultScanDepth = 4
+
+// scanSkippedDirs are directories that never hold projects and are expensive to walk
+var scanSkippedDirs = []string{"node_modules", "vendor", "__pycache__", "venv", "target", "dist", "build"}
+
+// ScannedProject is a directory with compose files found by a scan
+type ScannedProject struct {
+	Path string
+	// Name is the project name derived from the directory
+	Name string
+	// Registered is the name the directory is already registered under, if any
+	Registered string
+}
+
+// ScanComposeProjects walks root down to depth levels and returns the directories that contain
+// compose files, sorted by path. Hidden directories and dependency folders are skipped.
+func ScanComposeProjects(root string, depth int) ([]ScannedProject, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	registered := registeredProjectDirs()
+	var found []ScannedProject
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Unreadable directories are skipped rather than failing the whole scan
+			if entry != nil && entry.IsDir() && path != root {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+
+		if path != root {
+			name := entry.Name()
+			if strings.HasPrefix(name, ".") || contains(scanSkippedDirs, name) {
+				return fs.SkipDir
+			}
+		}
+
+		if utils.HasDockerComposeFiles(path) {
+			found = append(found, ScannedProject{
+				Path:       path,
+				Name:       DeriveProjectName(path),
+				Registered: registered[filepath.Clean(path)],
+			})
+		}
+
+		relative, err := filepath.Rel(root, path)
+		if err == nil && relative != "." && strings.Count(relative, string(filepath.Separator))+1 >= depth {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan %s: %v", root, err)
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].Path < found[j].Path })
+	return found, nil
+}
+
+// DeriveProjectName returns the project name of a directory, the same name compose gives the project
+func DeriveProjectName(path string) string {
+	return strings.ToLower(filepath.Base(path))
+}
+
+// RegisterProjects adds the given projects, name to path, and saves projects.json
+func RegisterProjects(projects map[string]string) error {
+	for name := range projects {
+		if _, exists := Projects[name]; exists {
+			return fmt.Errorf("project %s already exists", name)
+		}
+	}
+	for name, path := range projects {
+		Projects[name] = path
+	}
+
+	if err := SaveProjectsToFile("projects.json"); err != nil {
+		return fmt.Errorf("failed to save projects: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package docker
+
+import (
+	"crypto/rand"
+	"dockyard/pkg/utils"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Operations a schedule can run on a project
+const (
+	ScheduleStart   = "start"
+	ScheduleStop    = "stop"
+	ScheduleRestart = "restart"
+	SchedulePull    = "pull"
+	// ScheduleUpdate pulls new images and recreates the containers of a running project whose images changed
+	ScheduleUpdate = "update"
+)
+
+// ScheduleOperations are the operations a schedule accepts
+var ScheduleOperations = []string{ScheduleStart, ScheduleStop, ScheduleRestart, SchedulePull, ScheduleUpdate}
+
+// Schedule is a recurring operation on a project
+type Schedule struct {
+	ID        string    `json:"id"`
+	Project   string    `json:"project"`
+	Operation string    `json:"operation"`
+	Cron      string    `json:"cron"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// schedulesPath returns the file schedules are stored in
+func schedulesPath() (string, error) {
+	return utils.ConfigDir("schedules.json")
+}
+
+// ParseCron parses a standard five field cron expression or a descriptor such as @daily
+func ParseCron(expression string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %v", expression, err)
+	}
+	return schedule, nil
+}
+
+// LoadSchedules returns the stored schedules sorted by project and operation
+func LoadSchedules() ([]Schedule, error) {
+	path, err := schedulesPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schedules: %v", err)
+	}
+
+	var schedules []Schedule
+	if err := json.Unmarshal(data, &schedules); err != nil {
+		return nil, fmt.Errorf("failed to parse schedules: %v", err)
+	}
+
+	sort.SliceStable(schedules, func(i, j int) bool {
+		if schedules[i].Project != schedules[j].Project {
+			return schedules[i].Project < schedules[j].Project
+		}
+		return schedules[i].Operation < schedules[j].Operation
+	})
+	return schedules, nil
+}
+
+// saveSchedules replaces the stored schedules
+func saveSchedules(schedules []Schedule) error {
+	path, err := schedulesPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(schedules, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to save schedules: %v", err)
+	}
+	return nil
+}
+
+// AddSchedule validates and stores a recurring operation on a registered project
+func AddSchedule(projectName, operation, expression string) (Schedule, error) {
+	if _, ok := Projects[projectName]; !ok {
+		return Schedule{}, fmt.Errorf("project %s not found", projectName)
+	}
+	if !contains(ScheduleOperations, operation) {
+		return Schedule{}, fmt.Errorf("invalid operation %q, expected one of: %s", operation, strings.Join(ScheduleOperations, ", "))
+	}
+	if _, err := ParseCron(expression); err != nil {
+		return Schedule{}, err
+	}
+
+	schedules, err := LoadSchedules()
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	id := make([]byte, 4)
+	if _, err := rand.Read(id); err != nil {
+		return Schedule{}, err
+	}
+	schedule := Schedule{
+		ID:        hex.EncodeToString(id),
+		Project:   projectName,
+		Operation: operation,
+		Cron:      expression,
+		CreatedAt: time.Now(),
+	}
+
+	if err := saveSchedules(append(schedules, schedule)); err != nil {
+		return Schedule{}, err
+	}
+	return schedule, nil
+}
+
+// RemoveSchedule deletes the schedule with the given ID and returns it
+func RemoveSchedule(id string) (Schedule, error) {
+	schedules, err := LoadSchedules()
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	for i, schedule := range schedules {
+		if schedule.ID == id {
+			if err := saveSchedules(append(schedules[:i], schedules[i+1:]...)); err != nil {
+				return Schedule{}, err
+			}
+			return schedule, nil
+		}
+	}
+	return Schedule{}, fmt.Errorf("schedule %s not found", id)
+}
+
+// RunScheduledOperation runs a scheduled operation on a project
+func (cm *ComposeManager) RunScheduledOperation(projectName, projectDir, operation string) error {
+	start := StartOptions{
+		Detached:      true,
+		RemoveOrphans: true,
+		Scale:         ProjectsSettings[projectName].Scale,
+	}
+
+	switch operation {
+	case ScheduleStart:
+		return cm.StartProject(projectDir, start)
+	case ScheduleStop:
+		return cm.StopProject(projectDir, false, false)
+	case ScheduleRestart:
+		return cm.RestartProject(projectDir, RestartOptions{})
+	case SchedulePull:
+		return cm.PullImages(projectDir)
+	case ScheduleUpdate:
+		result := cm.PullProjectQuietly(projectDir)
+		if result.Err != nil {
+			if result.Output != "" {
+				return fmt.Errorf("%v: %s", result.Err, result.Output)
+			}
+			return result.Err
+		}
+		if len(result.Changed) == 0 {
+			fmt.Printf("✅ %s is up to date\n", projectName)
+			return nil
+		}
+		if !result.Running {
+			fmt.Printf("📥 Pulled new images of %s, not recreating it since it is not running\n", strings.Join(result.Changed, ", "))
+			return nil
+		}
+		fmt.Printf("♻️  Recreating %s with new images of %s\n", projectName, strings.Join(result.Changed, ", "))
+		return cm.StartProject(projectDir, start)
+	default:
+		return fmt.Errorf("invalid operation %q", operation)
+	}
+}
@@ -1,21 +1,65 @@
 package docker
 
 import (
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/AlecAivazis/survey/v2"
 )
 
+// FuzzySelectEnv enables fuzzy filtering in project selection prompts when set to a true value
+const FuzzySelectEnv = "DOCKYARD_FUZZY_SELECT"
+
+// projectSelectPageSize is the number of projects visible at once in selection prompts
+const projectSelectPageSize = 15
+
+// SelectProjects prompts for the projects to start
 func SelectProjects() ([]string, error) {
 	projectNames := GetSortedProjectNames()
 
 	var selectedProjects []string
 	prompt := &survey.MultiSelect{
-		Message: "Which projects do you want to start?",
-		Options: projectNames,
+		Message:     "Which projects do you want to start?",
+		Options:     projectNames,
+		Description: ProjectNoteDescription,
 	}
-	err := survey.AskOne(prompt, &selectedProjects)
+	err := survey.AskOne(prompt, &selectedProjects, ProjectSelectOptions()...)
 	if err != nil {
 		return nil, err
 	}
 
 	return selectedProjects, nil
 }
+
+// ProjectNoteDescription shows the note of a project next to it in selection prompts
+func ProjectNoteDescription(projectName string, _ int) string {
+	return ProjectsSettings[projectName].Note
+}
+
+// ProjectSelectOptions returns the prompt options used when selecting among projects. Typing
+// always narrows the list to names containing the text, ignoring case. With fuzzy selection
+// enabled, the typed characters only need to appear in order, so "apb" finds "api-backend".
+func ProjectSelectOptions() []survey.AskOpt {
+	options := []survey.AskOpt{survey.WithPageSize(projectSelectPageSize)}
+
+	if enabled, _ := strconv.ParseBool(os.Getenv(FuzzySelectEnv)); enabled {
+		options = append(options, survey.WithFilter(func(filter string, option string, _ int) bool {
+			return fuzzyMatch(filter, option)
+		}))
+	}
+	return options
+}
+
+// fuzzyMatch reports whether the characters of filter appear in order in option, ignoring case
+func fuzzyMatch(filter, option string) bool {
+	option = strings.ToLower(option)
+	for _, r := range strings.ToLower(filter) {
+		index := strings.IndexRune(option, r)
+		if index < 0 {
+			return false
+		}
+		option = option[index+len(string(r)):]
+	}
+	return true
+}
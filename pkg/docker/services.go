@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Image states reported by GetServiceImages
+const (
+	ImageCurrent     = "current"
+	ImageOutdated    = "outdated"
+	ImageNoContainer = "no container"
+)
+
+// ServiceImage compares the image a service declares with the image its container runs
+type ServiceImage struct {
+	Service string
+	// Configured is the image of the compose file, or the image compose builds for build-based services
+	Configured   string
+	Built        bool
+	ConfiguredID string // ID of the configured image in the local image store, if present
+	Running      string // image reference of the container
+	RunningID    string
+	Status       string
+}
+
+// GetServiceImages lists each service of the project with its configured and running image. A
+// container is outdated when the configured image now resolves to a different image ID, as after
+// a pull or a build that was not followed by a recreate.
+func (cm *ComposeManager) GetServiceImages(projectDir string) ([]ServiceImage, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []ServiceImage
+	for _, service := range project.Services {
+		image := ServiceImage{Service: service.Name, Configured: service.Image, Status: ImageNoContainer}
+		if image.Configured == "" && service.Build != nil {
+			image.Configured = fmt.Sprintf("%s-%s", project.Name, service.Name)
+			image.Built = true
+		}
+
+		if inspect, _, err := cm.dockerClient.ImageInspectWithRaw(cm.ctx, image.Configured); err == nil {
+			image.ConfiguredID = inspect.ID
+		}
+
+		for _, cont := range containers {
+			if cont.Labels[LabelComposeService] != service.Name {
+				continue
+			}
+			// Prefer a running replica, replicas share their image otherwise
+			if image.RunningID == "" || cont.State == "running" {
+				image.Running, image.RunningID = cont.Image, cont.ImageID
+			}
+		}
+
+		switch {
+		case image.RunningID == "":
+		case image.ConfiguredID != "" && image.ConfiguredID != image.RunningID:
+			image.Status = ImageOutdated
+		default:
+			image.Status = ImageCurrent
+		}
+		images = append(images, image)
+	}
+
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Service < images[j].Service
+	})
+	return images, nil
+}
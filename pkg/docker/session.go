@@ -0,0 +1,118 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultSessionName is used when session save or restore is given no name
+const DefaultSessionName = "default"
+
+// sessionNamePattern restricts session names to safe file names
+var sessionNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Session is a named set of projects that were running together
+type Session struct {
+	Name     string    `json:"name"`
+	Projects []string  `json:"projects"`
+	SavedAt  time.Time `json:"saved_at"`
+}
+
+// sessionsDir returns the directory sessions are stored in
+func sessionsDir() (string, error) {
+	return utils.ConfigDir("sessions")
+}
+
+// sessionPath returns the file holding a session, rejecting names that are not plain file names
+func sessionPath(name string) (string, error) {
+	if !sessionNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid session name %q, use letters, digits, '.', '_' and '-'", name)
+	}
+	dir, err := sessionsDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+// SaveSession records the given projects under a session name, replacing any previous session
+func SaveSession(name string, projects []string) (Session, error) {
+	path, err := sessionPath(name)
+	if err != nil {
+		return Session{}, err
+	}
+
+	session := Session{Name: name, Projects: append([]string(nil), projects...), SavedAt: time.Now()}
+	sort.Strings(session.Projects)
+
+	data, err := json.MarshalIndent(session, "", "  ")
+	if err != nil {
+		return Session{}, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return Session{}, fmt.Errorf("failed to create sessions directory: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return Session{}, fmt.Errorf("failed to save session: %v", err)
+	}
+	return session, nil
+}
+
+// LoadSession reads a saved session
+func LoadSession(name string) (Session, error) {
+	path, err := sessionPath(name)
+	if err != nil {
+		return Session{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Session{}, fmt.Errorf("session %s not found", name)
+	}
+	if err != nil {
+		return Session{}, fmt.Errorf("failed to read session: %v", err)
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return Session{}, fmt.Errorf("failed to parse session %s: %v", name, err)
+	}
+	return session, nil
+}
+
+// ListSessions returns all saved sessions sorted by name
+func ListSessions() ([]Session, error) {
+	dir, err := sessionsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sessions directory: %v", err)
+	}
+
+	var sessions []Session
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		session, err := LoadSession(name)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
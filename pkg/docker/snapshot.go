@@ -0,0 +1,130 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// SnapshotLogLines is the number of log lines per service included in a snapshot
+const SnapshotLogLines = 200
+
+// ProjectSnapshot is a diagnostic report of a project's configuration and runtime state
+type ProjectSnapshot struct {
+	Project    string            `json:"project"`
+	Directory  string            `json:"directory"`
+	Context    string            `json:"context,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Redacted   bool              `json:"redacted"`
+	Config     json.RawMessage   `json:"config,omitempty"`
+	Containers []ContainerStatus `json:"containers"`
+	Resources  []ResourceUsage   `json:"resources"`
+	Health     map[string]string `json:"health"`
+	Logs       string            `json:"logs"`
+	// Errors lists the parts of the snapshot that could not be collected
+	Errors []string `json:"errors,omitempty"`
+}
+
+// TakeSnapshot collects the resolved compose config, container statuses, resource usage, service
+// health and a tail of the logs of a project. Parts that fail are recorded in Errors so a
+// snapshot of a broken project is still useful. With redact, secret values are masked.
+func (cm *ComposeManager) TakeSnapshot(projectName, projectDir string, redact bool) (*ProjectSnapshot, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &ProjectSnapshot{
+		Project:   projectName,
+		Directory: projectDir,
+		Context:   CurrentContext(),
+		CreatedAt: time.Now(),
+		Redacted:  redact,
+	}
+
+	redactor := utils.NewRedactor(ProjectEnvironment(project))
+	mask := func(text string) string {
+		if redact {
+			return redactor.Redact(text)
+		}
+		return text
+	}
+
+	if snapshot.Config, err = snapshotConfig(project, redactor, redact); err != nil {
+		snapshot.Errors = append(snapshot.Errors, fmt.Sprintf("config: %v", err))
+	}
+
+	if snapshot.Containers, err = cm.GetProjectStatus(projectDir); err != nil {
+		snapshot.Errors = append(snapshot.Errors, fmt.Sprintf("containers: %v", err))
+	}
+	if snapshot.Resources, err = cm.GetProjectResourceUsage(projectDir); err != nil {
+		snapshot.Errors = append(snapshot.Errors, fmt.Sprintf("resources: %v", err))
+	}
+	if snapshot.Health, err = cm.GetServiceReadiness(project); err != nil {
+		snapshot.Errors = append(snapshot.Errors, fmt.Sprintf("health: %v", err))
+	}
+
+	logs, err := tailProjectLogs(projectDir, SnapshotLogLines)
+	if err != nil {
+		snapshot.Errors = append(snapshot.Errors, fmt.Sprintf("logs: %v", err))
+	}
+	snapshot.Logs = mask(logs)
+
+	return snapshot, nil
+}
+
+// snapshotConfig encodes the resolved project. Redaction is applied to the decoded values rather
+// than to the encoded text so that the result stays valid JSON.
+func snapshotConfig(project *types.Project, redactor *utils.Redactor, redact bool) (json.RawMessage, error) {
+	config, err := json.Marshal(project)
+	if err != nil || !redact {
+		return config, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(config, &decoded); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactJSONValue(decoded, redactor))
+}
+
+// redactJSONValue masks the values of secret keys and known secret values in a decoded JSON value
+func redactJSONValue(value any, redactor *utils.Redactor) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if text, ok := item.(string); ok && redactor.IsSecretKey(key) {
+				v[key] = redactor.RedactValue(key, text)
+				continue
+			}
+			v[key] = redactJSONValue(item, redactor)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSONValue(item, redactor)
+		}
+	case string:
+		return redactor.Redact(v)
+	}
+	return value
+}
+
+// tailProjectLogs returns the last lines of each service's logs
+func tailProjectLogs(projectDir string, lines int) (string, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.Command(CommandDocker, utils.ComposeArgs(composeFilePath, "logs", "--no-color", "--timestamps", "--tail", fmt.Sprint(lines))...)
+	cmd.Dir = projectDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("failed to read logs: %v", err)
+	}
+	return string(output), nil
+}
@@ -0,0 +1,293 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"gopkg.in/yaml.v3"
+)
+
+// composeProjectNamePattern matches the project names docker compose accepts
+var composeProjectNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// PortRemap is a published port of a spawned stack moved to a free host port
+type PortRemap struct {
+	Service  string
+	Target   int
+	Protocol string
+	From     int
+	To       int
+}
+
+// Spawn is a second instance of a project running under another compose project name
+type Spawn struct {
+	Name        string
+	ComposeFile string
+	Ports       []PortRemap
+}
+
+// spawnsDir returns the directory the compose files of spawned stacks are stored in
+func spawnsDir() (string, error) {
+	return utils.ConfigDir("spawns")
+}
+
+// spawnComposeFile returns the compose file of a spawned stack, rejecting invalid project names
+func spawnComposeFile(name string) (string, error) {
+	if !composeProjectNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid project name %q, use lowercase letters, digits, '_' and '-'", name)
+	}
+	dir, err := spawnsDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".yaml"), nil
+}
+
+// SpawnProject starts a copy of a project under another compose project name. Compose merges the
+// ports of override files instead of replacing them and cannot unset container names, so the
+// resolved configuration of the project is written to a compose file of its own in which
+// published ports are moved to free host ports, container names are dropped and volumes and
+// networks are renamed after the new project.
+func (cm *ComposeManager) SpawnProject(projectDir, name string) (*Spawn, error) {
+	if err := CheckDockerStatus(); err != nil {
+		return nil, err
+	}
+
+	spawnFile, err := spawnComposeFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	if project.Name == name {
+		return nil, fmt.Errorf("the copy needs a name other than %s", name)
+	}
+	if err := cm.checkSpawnNameFree(name, spawnFile); err != nil {
+		return nil, err
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	output, err := composeOutput(projectDir, composeFilePath, "config", "--format", "json")
+	if err != nil {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(output))
+	}
+
+	var config map[string]any
+	if err := json.Unmarshal([]byte(output), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse compose config: %v", err)
+	}
+
+	used, err := cm.publishedPorts()
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := rewriteSpawnConfig(config, name, used)
+	if err != nil {
+		return nil, err
+	}
+	spawn := &Spawn{Name: name, ComposeFile: spawnFile, Ports: ports}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write compose file: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(spawnFile), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create spawns directory: %v", err)
+	}
+	_, statErr := os.Stat(spawnFile)
+	existed := statErr == nil
+	// The resolved config holds the interpolated values of the environment, secrets included
+	if err := os.WriteFile(spawnFile, data, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write compose file: %v", err)
+	}
+
+	fmt.Printf("🧬 Spawning %s as %s\n", project.Name, name)
+	if err := cm.executeCommandWithErrorHandling(projectDir, "compose", "-p", name, "-f", spawnFile, "up", "-d"); err != nil {
+		// Keep the file of an earlier spawn, --rm needs it to remove that stack
+		if !existed {
+			os.Remove(spawnFile)
+		}
+		return nil, err
+	}
+	return spawn, nil
+}
+
+// checkSpawnNameFree refuses a spawn name that is the compose project name of a registered project
+// or of existing containers, since compose would then recreate, and --rm delete, that stack.
+// The containers of an earlier spawn with the same name are its own and do not count.
+func (cm *ComposeManager) checkSpawnNameFree(name, spawnFile string) error {
+	if _, ok := Projects[name]; ok {
+		return fmt.Errorf("%s is a registered project, pick another name", name)
+	}
+	for dir, projectName := range registeredProjectDirs() {
+		if DeriveProjectName(dir) == name {
+			return fmt.Errorf("%s is the compose project name of project %s, pick another name", name, projectName)
+		}
+	}
+
+	if _, err := os.Stat(spawnFile); err == nil {
+		return nil
+	}
+	containers, err := cm.GetProjectContainers(name)
+	if err != nil {
+		return err
+	}
+	if len(containers) > 0 {
+		return fmt.Errorf("containers of a compose project named %s already exist, pick another name", name)
+	}
+	return nil
+}
+
+// rewriteSpawnConfig renames a resolved compose config after the new project and moves its
+// published ports to free host ports
+func rewriteSpawnConfig(config map[string]any, name string, used map[string]bool) ([]PortRemap, error) {
+	var remaps []PortRemap
+	config["name"] = name
+	for _, key := range []string{"networks", "volumes"} {
+		resources, _ := config[key].(map[string]any)
+		for _, resource := range resources {
+			if resource, ok := resource.(map[string]any); ok && resource["external"] != true {
+				delete(resource, "name")
+			}
+		}
+	}
+
+	services, _ := config["services"].(map[string]any)
+	serviceNames := make([]string, 0, len(services))
+	for serviceName := range services {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		service, ok := services[serviceName].(map[string]any)
+		if !ok {
+			continue
+		}
+		delete(service, "container_name")
+
+		ports, _ := service["ports"].([]any)
+		for _, port := range ports {
+			port, ok := port.(map[string]any)
+			if !ok || port["published"] == nil || fmt.Sprint(port["published"]) == "" {
+				continue
+			}
+
+			published := fmt.Sprint(port["published"])
+			from, err := strconv.Atoi(published)
+			if err != nil {
+				return nil, fmt.Errorf("service %s publishes port range %s, ranges cannot be remapped", serviceName, published)
+			}
+
+			protocol := "tcp"
+			if value, ok := port["protocol"].(string); ok && value != "" {
+				protocol = value
+			}
+			hostIP, _ := port["host_ip"].(string)
+
+			to, err := nextFreePort(from, hostIP, protocol, used)
+			if err != nil {
+				return nil, err
+			}
+			used[portKey(to, protocol)] = true
+			port["published"] = strconv.Itoa(to)
+
+			target, _ := port["target"].(float64)
+			remaps = append(remaps, PortRemap{
+				Service:  serviceName,
+				Target:   int(target),
+				Protocol: protocol,
+				From:     from,
+				To:       to,
+			})
+		}
+	}
+
+	return remaps, nil
+}
+
+// RemoveSpawn takes a spawned stack down with its volumes and deletes its compose file
+func (cm *ComposeManager) RemoveSpawn(projectDir, name string) error {
+	if err := CheckDockerStatus(); err != nil {
+		return err
+	}
+
+	spawnFile, err := spawnComposeFile(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(spawnFile); err != nil {
+		return fmt.Errorf("no spawned stack named %s", name)
+	}
+
+	fmt.Printf("🗑️  Removing spawned stack %s\n", name)
+	if err := cm.executeCommandWithErrorHandling(projectDir, "compose", "-p", name, "-f", spawnFile, "down", "-v", "--remove-orphans"); err != nil {
+		return err
+	}
+	return os.Remove(spawnFile)
+}
+
+// publishedPorts returns the host ports published by the running containers, keyed by portKey
+func (cm *ComposeManager) publishedPorts() (map[string]bool, error) {
+	containers, err := cm.dockerClient.ContainerList(cm.ctx, dockertypes.ContainerListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %v", err)
+	}
+
+	used := make(map[string]bool)
+	for _, cont := range containers {
+		for _, port := range cont.Ports {
+			if port.PublicPort != 0 {
+				used[portKey(int(port.PublicPort), port.Type)] = true
+			}
+		}
+	}
+	return used, nil
+}
+
+// nextFreePort returns the first port above from that no container publishes and that can be bound
+func nextFreePort(from int, hostIP, protocol string, used map[string]bool) (int, error) {
+	for port := from + 1; port <= 65535; port++ {
+		if used[portKey(port, protocol)] {
+			continue
+		}
+
+		address := net.JoinHostPort(hostIP, strconv.Itoa(port))
+		if protocol == "udp" {
+			listener, err := net.ListenPacket("udp", address)
+			if err != nil {
+				continue
+			}
+			listener.Close()
+		} else {
+			listener, err := net.Listen("tcp", address)
+			if err != nil {
+				continue
+			}
+			listener.Close()
+		}
+		return port, nil
+	}
+	return 0, fmt.Errorf("no free %s port above %d", protocol, from)
+}
+
+// portKey identifies a host port and its protocol
+func portKey(port int, protocol string) string {
+	return fmt.Sprintf("%d/%s", port, protocol)
+}
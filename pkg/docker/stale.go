@@ -0,0 +1,97 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"dockyard/pkg/utils"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// ServiceFreshness compares the config hash of a service's container with the current compose config
+type ServiceFreshness struct {
+	Service      string
+	ExpectedHash string
+	CurrentHash  string // empty when the service has no container
+}
+
+// HasContainer reports whether the service has a container to compare
+func (sf ServiceFreshness) HasContainer() bool {
+	return sf.CurrentHash != ""
+}
+
+// IsStale reports whether the container was created from an older configuration
+func (sf ServiceFreshness) IsStale() bool {
+	return sf.HasContainer() && sf.CurrentHash != sf.ExpectedHash
+}
+
+// GetConfigHashes returns the config hash compose computes for each service of the project
+func (cm *ComposeManager) GetConfigHashes(projectDir string) (map[string]string, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(CommandDocker, utils.ComposeArgs(composeFilePath, "config", "--hash", "*")...)
+	cmd.Dir = projectDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute config hashes: %s", strings.TrimSpace(string(output)))
+	}
+
+	hashes := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 {
+			hashes[fields[0]] = fields[1]
+		}
+	}
+
+	return hashes, scanner.Err()
+}
+
+// FindStaleServices compares the config hash label of each service's containers with the
+// hash of the current compose configuration
+func (cm *ComposeManager) FindStaleServices(projectDir string) ([]ServiceFreshness, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := cm.GetConfigHashes(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]string)
+	for _, cont := range containers {
+		service := cont.Labels["com.docker.compose.service"]
+		hash := cont.Labels["com.docker.compose.config-hash"]
+		// With replicas, a single outdated container makes the service stale
+		if existing, ok := current[service]; !ok || existing == expected[service] {
+			current[service] = hash
+		}
+	}
+
+	var results []ServiceFreshness
+	for service, hash := range expected {
+		results = append(results, ServiceFreshness{
+			Service:      service,
+			ExpectedHash: hash,
+			CurrentHash:  current[service],
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Service < results[j].Service
+	})
+	return results, nil
+}
@@ -0,0 +1,101 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"golang.org/x/term"
+)
+
+// ValidateDetachKeys checks a detach key sequence such as "ctrl-p,ctrl-q". Each key is a single
+// character or ctrl- followed by a letter or one of @[\]^_, as accepted by the docker daemon.
+func ValidateDetachKeys(keys string) error {
+	for _, key := range strings.Split(keys, ",") {
+		if len(key) == 1 {
+			continue
+		}
+		control, ok := strings.CutPrefix(key, "ctrl-")
+		if ok && len(control) == 1 {
+			c := strings.ToLower(control)[0]
+			if (c >= 'a' && c <= 'z') || strings.IndexByte(`@[\]^_`, c) >= 0 {
+				continue
+			}
+		}
+		return fmt.Errorf("invalid detach key %q in %q, use keys such as ctrl-p,ctrl-q", key, keys)
+	}
+	return nil
+}
+
+// IsInteractiveTerminal reports whether both stdin and stdout are terminals
+func IsInteractiveTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// terminalSize returns the [height, width] of the terminal on stdout
+func terminalSize() *[2]uint {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return nil
+	}
+	return &[2]uint{uint(height), uint(width)}
+}
+
+// streamTerminal connects the terminal in raw mode to a TTY stream until the stream ends,
+// resizing the remote TTY whenever the terminal window changes size
+func streamTerminal(stream types.HijackedResponse, resize func(height, width uint) error) error {
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("failed to put the terminal in raw mode: %v", err)
+	}
+	defer term.Restore(fd, state)
+
+	stop := propagateResize(resize)
+	defer stop()
+
+	go func() {
+		_, _ = io.Copy(stream.Conn, os.Stdin)
+		_ = stream.CloseWrite()
+	}()
+
+	// A TTY stream is not multiplexed, so it is copied as is
+	if _, err := io.Copy(os.Stdout, stream.Reader); err != nil {
+		return fmt.Errorf("terminal stream failed: %v", err)
+	}
+	return nil
+}
+
+// propagateResize applies the current terminal size, then again on every window size change
+// until the returned function is called
+func propagateResize(resize func(height, width uint) error) func() {
+	apply := func() {
+		if size := terminalSize(); size != nil {
+			_ = resize(size[0], size[1])
+		}
+	}
+	apply()
+
+	signals := make(chan os.Signal, 1)
+	utils.NotifyResize(signals)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-signals:
+				apply()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+)
+
+// RunTests runs the project in the foreground with docker compose up --abort-on-container-exit,
+// streaming its output, and returns the exit code of the exitCodeFrom service. The stack is taken
+// down afterwards, even when the run fails or is interrupted.
+func (cm *ComposeManager) RunTests(projectDir, exitCodeFrom string) (int, error) {
+	// Check Docker health first
+	if err := CheckDockerStatus(); err != nil {
+		return 0, err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := project.GetService(exitCodeFrom); err != nil {
+		return 0, fmt.Errorf("service %s not found in project %s", exitCodeFrom, project.Name)
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return 0, err
+	}
+
+	// Ctrl-C reaches docker compose, which stops the containers, dockyard stays alive to clean up
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	exitCode, runErr := cm.runTestStack(projectDir, testArguments(composeFilePath, exitCodeFrom))
+
+	fmt.Println("🧹 Tearing down the test stack")
+	if err := cm.executeCommandWithErrorHandling(projectDir, downArguments(composeFilePath, false, false)...); err != nil {
+		if runErr == nil {
+			runErr = fmt.Errorf("failed to tear down the test stack: %v", err)
+		} else {
+			fmt.Printf("⚠️  Failed to tear down the test stack: %v\n", err)
+		}
+	}
+
+	return exitCode, runErr
+}
+
+// runTestStack runs compose up in the foreground and returns its exit code
+func (cm *ComposeManager) runTestStack(projectDir string, args []string) (int, error) {
+	cmd, ctx, cancel := cm.dockerCommand(projectDir, args...)
+	defer cancel()
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Run()
+	if timeoutErr := cm.timeoutError(ctx, args); timeoutErr != nil {
+		return 0, timeoutErr
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to run docker compose up: %v", err)
+	}
+	return 0, nil
+}
+
+func testArguments(composeFilePath, exitCodeFrom string) []string {
+	return utils.ComposeArgs(composeFilePath, "up", "--abort-on-container-exit", "--exit-code-from", exitCodeFrom)
+}
@@ -0,0 +1,127 @@
+package docker
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// DefaultTimelineSince is how far back a timeline goes when no start is given
+const DefaultTimelineSince = "24h"
+
+// Sources of timeline entries
+const (
+	TimelineDockyard = "dockyard"
+	TimelineDocker   = "docker"
+)
+
+// TimelineEntry is an operation run by dockyard or an event reported by the docker daemon
+type TimelineEntry struct {
+	Time   time.Time
+	Source string
+	// Subject is the service an event concerns, empty for dockyard operations
+	Subject string
+	Action  string
+	Error   string
+}
+
+// ParseSince parses the start of a time window, either a duration back from now such as "2h"
+// or a date such as "2024-05-01" or "2024-05-01T15:04:05Z"
+func ParseSince(since string) (time.Time, error) {
+	if duration, err := time.ParseDuration(since); err == nil && duration > 0 {
+		return time.Now().Add(-duration), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, since, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, use a duration such as 2h or a date such as 2024-05-01", since)
+}
+
+// ProjectTimeline merges the operations dockyard ran on a project with the docker events of its
+// containers since the given time, in chronological order. The daemon only keeps a limited number
+// of recent events, so older docker events may be missing.
+func (cm *ComposeManager) ProjectTimeline(projectName, projectDir string, since time.Time) ([]TimelineEntry, error) {
+	history, err := ProjectHistory(projectName, since)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := cm.projectEvents(project.Name, since)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := make([]TimelineEntry, 0, len(history)+len(messages))
+	for _, entry := range history {
+		timeline = append(timeline, TimelineEntry{
+			Time:   entry.Time,
+			Source: TimelineDockyard,
+			Action: entry.Action,
+			Error:  entry.Error,
+		})
+	}
+	for _, message := range messages {
+		entry := TimelineEntry{
+			Time:    time.Unix(0, message.TimeNano),
+			Source:  TimelineDocker,
+			Subject: message.Actor.Attributes[LabelComposeService],
+			Action:  string(message.Action),
+		}
+		if message.Action == "die" && message.Actor.Attributes["exitCode"] != "0" {
+			entry.Error = "exit code " + message.Actor.Attributes["exitCode"]
+		}
+		timeline = append(timeline, entry)
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Time.Before(timeline[j].Time)
+	})
+	return timeline, nil
+}
+
+// projectEvents returns the container events of a compose project since the given time. Exec
+// events are left out since health checks and exec sessions would drown the others.
+func (cm *ComposeManager) projectEvents(composeProject string, since time.Time) ([]events.Message, error) {
+	if err := cm.ensureDockerRunning(); err != nil {
+		return nil, fmt.Errorf("docker is not accessible: %v", err)
+	}
+
+	filterArgs := filters.NewArgs()
+	filterArgs.Add("type", "container")
+	filterArgs.Add("label", fmt.Sprintf("%s=%s", LabelComposeProject, composeProject))
+
+	// With an end time the daemon replays past events and closes the stream
+	messageCh, errCh := cm.dockerClient.Events(cm.ctx, dockertypes.EventsOptions{
+		Since:   strconv.FormatInt(since.Unix(), 10),
+		Until:   strconv.FormatInt(time.Now().Unix(), 10),
+		Filters: filterArgs,
+	})
+
+	var messages []events.Message
+	for {
+		select {
+		case message := <-messageCh:
+			if !strings.HasPrefix(string(message.Action), "exec_") {
+				messages = append(messages, message)
+			}
+		case err := <-errCh:
+			if err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to read docker events: %v", err)
+			}
+			return messages, nil
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// MinRecommendedNofile is the open files limit below which data stores are flagged
+const MinRecommendedNofile = 65536
+
+// Tunable sources
+const (
+	TunableCompose = "compose"
+	TunableDefault = "default"
+)
+
+// reportedUlimits are the limits always shown, other ones only when the compose file sets them
+var reportedUlimits = []string{"nofile", "nproc", "memlock"}
+
+// procLimitNames maps the rows of /proc/<pid>/limits to ulimit names
+var procLimitNames = map[string]string{
+	"Max core file size":  "core",
+	"Max stack size":      "stack",
+	"Max processes":       "nproc",
+	"Max open files":      "nofile",
+	"Max locked memory":   "memlock",
+	"Max pending signals": "sigpending",
+	"Max msgqueue size":   "msgqueue",
+}
+
+// fileDescriptorHungryImages are images that need many open files under load
+var fileDescriptorHungryImages = []string{
+	"postgres", "mysql", "mariadb", "mongo", "redis", "elasticsearch", "opensearch",
+	"cassandra", "kafka", "rabbitmq", "clickhouse", "nginx", "haproxy",
+}
+
+// Tunable is a ulimit or sysctl of a service, as configured and as seen in its container
+type Tunable struct {
+	Name       string
+	Configured string
+	// Effective is the value in the running container, empty when unknown
+	Effective string
+	// Host is the value of a sysctl on the host running dockyard, empty when unknown
+	Host   string
+	Source string
+}
+
+// ServiceTunables are the ulimits and sysctls of a service
+type ServiceTunables struct {
+	Service   string
+	Container string // first running container, empty when none runs
+	Ulimits   []Tunable
+	Sysctls   []Tunable
+	Warnings  []string
+}
+
+// ProjectTunables reports the ulimits and sysctls of each service of a project, sorted by
+// service. Effective ulimits are read from /proc/1/limits and effective sysctls from /proc/sys
+// in the first running container of the service, so values left to the daemon defaults or
+// changed at runtime show their real values.
+func (cm *ComposeManager) ProjectTunables(projectDir string) ([]ServiceTunables, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+	running := make(map[string]dockertypes.Container)
+	for _, cont := range containers {
+		service := cont.Labels[LabelComposeService]
+		if _, ok := running[service]; !ok && cont.State == "running" {
+			running[service] = cont
+		}
+	}
+
+	var report []ServiceTunables
+	for _, service := range project.Services {
+		tunables := ServiceTunables{Service: service.Name}
+
+		effectiveUlimits := make(map[string]string)
+		effectiveSysctls := make(map[string]string)
+		if cont, ok := running[service.Name]; ok {
+			tunables.Container = strings.TrimPrefix(cont.Names[0], "/")
+			if output, err := cm.execOutput(cont.ID, []string{"cat", "/proc/1/limits"}); err == nil {
+				effectiveUlimits = parseProcLimits(output)
+			}
+			for name := range service.Sysctls {
+				if output, err := cm.execOutput(cont.ID, []string{"cat", sysctlPath(name)}); err == nil {
+					effectiveSysctls[name] = strings.Join(strings.Fields(output), " ")
+				}
+			}
+		}
+
+		names := append([]string(nil), reportedUlimits...)
+		for name := range service.Ulimits {
+			if !contains(names, name) {
+				names = append(names, name)
+			}
+		}
+		for _, name := range names {
+			tunable := Tunable{Name: name, Effective: effectiveUlimits[name], Source: TunableDefault}
+			if config, ok := service.Ulimits[name]; ok && config != nil {
+				tunable.Configured = formatUlimit(config)
+				tunable.Source = TunableCompose
+			}
+			tunables.Ulimits = append(tunables.Ulimits, tunable)
+		}
+
+		for name, value := range service.Sysctls {
+			tunables.Sysctls = append(tunables.Sysctls, Tunable{
+				Name:       name,
+				Configured: value,
+				Effective:  effectiveSysctls[name],
+				Host:       hostSysctl(name),
+				Source:     TunableCompose,
+			})
+		}
+		sort.Slice(tunables.Sysctls, func(i, j int) bool { return tunables.Sysctls[i].Name < tunables.Sysctls[j].Name })
+
+		tunables.Warnings = tunableWarnings(service, effectiveUlimits, tunables.Container != "")
+		report = append(report, tunables)
+	}
+
+	sort.Slice(report, func(i, j int) bool { return report[i].Service < report[j].Service })
+	return report, nil
+}
+
+// tunableWarnings flags data stores that rely on default limits which are likely too low. The
+// default open files limit is only known from a running container.
+func tunableWarnings(service types.ServiceConfig, effective map[string]string, running bool) []string {
+	kind := fileDescriptorHungryKind(service.Image)
+	if kind == "" {
+		return nil
+	}
+
+	var warnings []string
+	if _, ok := service.Ulimits["nofile"]; !ok {
+		soft, _, _ := strings.Cut(effective["nofile"], ":")
+		limit, err := strconv.Atoi(soft)
+		switch {
+		case soft == "" && !running:
+			warnings = append(warnings, fmt.Sprintf("nofile is not set and its default is unknown while the service is stopped, %s usually needs at least %d open files", kind, MinRecommendedNofile))
+		case soft == "":
+			warnings = append(warnings, fmt.Sprintf("nofile is not set and its default could not be read, %s usually needs at least %d open files", kind, MinRecommendedNofile))
+		case err == nil && limit < MinRecommendedNofile:
+			warnings = append(warnings, fmt.Sprintf("nofile soft limit is %d, %s usually needs at least %d open files", limit, kind, MinRecommendedNofile))
+		}
+	}
+	if _, ok := service.Ulimits["memlock"]; !ok && (kind == "elasticsearch" || kind == "opensearch") {
+		warnings = append(warnings, fmt.Sprintf("memlock is not set, %s needs it unlimited to lock its memory", kind))
+	}
+	return warnings
+}
+
+// fileDescriptorHungryKind returns which data store an image runs, empty for other images
+func fileDescriptorHungryKind(image string) string {
+	repository := image
+	if i := strings.LastIndex(repository, "/"); i >= 0 {
+		repository = repository[i+1:]
+	}
+	repository, _, _ = strings.Cut(repository, ":")
+	repository, _, _ = strings.Cut(repository, "@")
+
+	for _, kind := range fileDescriptorHungryImages {
+		if strings.HasPrefix(repository, kind) {
+			return kind
+		}
+	}
+	return ""
+}
+
+// sysctlPath returns the /proc/sys file of a sysctl, e.g. /proc/sys/net/core/somaxconn
+func sysctlPath(name string) string {
+	return "/proc/sys/" + strings.ReplaceAll(name, ".", "/")
+}
+
+// hostSysctl returns the value of a sysctl on this host, empty when it cannot be read
+func hostSysctl(name string) string {
+	data, err := os.ReadFile(sysctlPath(name))
+	if err != nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(string(data)), " ")
+}
+
+// formatUlimit renders a compose ulimit as soft:hard, or as its single value
+func formatUlimit(config *types.UlimitsConfig) string {
+	if config.Single != 0 {
+		return strconv.Itoa(config.Single)
+	}
+	return fmt.Sprintf("%d:%d", config.Soft, config.Hard)
+}
+
+// parseProcLimits reads the soft and hard values of /proc/<pid>/limits as soft:hard by ulimit name
+func parseProcLimits(output string) map[string]string {
+	limits := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		for row, name := range procLimitNames {
+			rest, ok := strings.CutPrefix(line, row)
+			if !ok {
+				continue
+			}
+			fields := strings.Fields(rest)
+			if len(fields) >= 2 {
+				limits[name] = fields[0] + ":" + fields[1]
+			}
+		}
+	}
+	return limits
+}
+
+// execOutput runs a command in a container and returns its standard output
+func (cm *ComposeManager) execOutput(containerID string, command []string) (string, error) {
+	created, err := cm.dockerClient.ContainerExecCreate(cm.ctx, containerID, dockertypes.ExecConfig{
+		Cmd:          command,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create exec: %v", err)
+	}
+
+	stream, err := cm.dockerClient.ContainerExecAttach(cm.ctx, created.ID, dockertypes.ExecStartCheck{})
+	if err != nil {
+		return "", fmt.Errorf("failed to attach to exec: %v", err)
+	}
+	defer stream.Close()
+
+	var stdout, stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(&stdout, &stderr, stream.Reader); err != nil {
+		return "", fmt.Errorf("failed to read exec output: %v", err)
+	}
+
+	inspect, err := cm.dockerClient.ContainerExecInspect(cm.ctx, created.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect exec: %v", err)
+	}
+	if inspect.ExitCode != 0 {
+		return "", fmt.Errorf("%s exited with code %d: %s", command[0], inspect.ExitCode, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
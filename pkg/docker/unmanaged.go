@@ -0,0 +1,162 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	dockertypes "github.com/docker/docker/api/types"
+)
+
+// UnmanagedContainer is a running container outside any registered project
+type UnmanagedContainer struct {
+	ID     string
+	Name   string
+	Image  string
+	Status string
+}
+
+// UnmanagedProject is a compose project with running containers that is not registered
+type UnmanagedProject struct {
+	Project    string
+	WorkingDir string
+	// ConfigFiles are the compose files the containers were created from
+	ConfigFiles []string
+	// Available is whether those compose files still exist and are found from the working
+	// directory, so the project can be registered
+	Available  bool
+	Containers []UnmanagedContainer
+}
+
+// UnmanagedContainers are the running containers dockyard does not know about
+type UnmanagedContainers struct {
+	Projects   []UnmanagedProject
+	Standalone []UnmanagedContainer
+}
+
+// IsEmpty reports whether every running container belongs to a registered project
+func (u *UnmanagedContainers) IsEmpty() bool {
+	return len(u.Projects) == 0 && len(u.Standalone) == 0
+}
+
+// FindUnmanagedContainers returns the running containers whose compose project is not registered,
+// grouped by compose project, and the standalone containers that have no compose project at all.
+// Remote projects and spawned stacks are known to dockyard and are left out.
+func (cm *ComposeManager) FindUnmanagedContainers() (*UnmanagedContainers, error) {
+	containers, err := cm.dockerClient.ContainerList(cm.ctx, dockertypes.ContainerListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %v", err)
+	}
+
+	// Compose names its projects after their directory, which can differ from the dockyard name
+	projectsByDir := registeredProjectDirs()
+	composeNames := make(map[string]bool)
+	for dir := range projectsByDir {
+		composeNames[DeriveProjectName(dir)] = true
+	}
+	knownDirs := knownUnregisteredDirs()
+
+	unmanaged := &UnmanagedContainers{}
+	byProject := make(map[string]*UnmanagedProject)
+	for _, cont := range containers {
+		entry := UnmanagedContainer{
+			ID:     cont.ID,
+			Name:   strings.TrimPrefix(cont.Names[0], "/"),
+			Image:  cont.Image,
+			Status: cont.Status,
+		}
+
+		composeProject := cont.Labels[LabelComposeProject]
+		if composeProject == "" {
+			unmanaged.Standalone = append(unmanaged.Standalone, entry)
+			continue
+		}
+		if _, registered := containerProject(cont, projectsByDir); registered || composeNames[composeProject] {
+			continue
+		}
+		workingDir := cont.Labels[LabelComposeWorkingDir]
+		if withinAny(workingDir, knownDirs) {
+			continue
+		}
+
+		project, ok := byProject[composeProject]
+		if !ok {
+			var configFiles []string
+			if label := cont.Labels[LabelComposeConfigFiles]; label != "" {
+				configFiles = strings.Split(label, ",")
+			}
+			project = &UnmanagedProject{
+				Project:     composeProject,
+				WorkingDir:  workingDir,
+				ConfigFiles: configFiles,
+				Available:   composeFilesAvailable(workingDir, configFiles),
+			}
+			byProject[composeProject] = project
+		}
+		project.Containers = append(project.Containers, entry)
+	}
+
+	for _, project := range byProject {
+		sort.Slice(project.Containers, func(i, j int) bool { return project.Containers[i].Name < project.Containers[j].Name })
+		unmanaged.Projects = append(unmanaged.Projects, *project)
+	}
+	sort.Slice(unmanaged.Projects, func(i, j int) bool { return unmanaged.Projects[i].Project < unmanaged.Projects[j].Project })
+	sort.Slice(unmanaged.Standalone, func(i, j int) bool { return unmanaged.Standalone[i].Name < unmanaged.Standalone[j].Name })
+	return unmanaged, nil
+}
+
+// knownUnregisteredDirs returns the directories of projects dockyard runs without a local path in
+// projects.json: the cache directories of remote projects and the spawned stacks
+func knownUnregisteredDirs() []string {
+	var dirs []string
+	for _, path := range Projects {
+		if !utils.IsRemoteSource(path) {
+			continue
+		}
+		if dir, err := utils.RemoteCacheDir(path); err == nil {
+			dirs = append(dirs, dir)
+		}
+	}
+	if dir, err := spawnsDir(); err == nil {
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+// withinAny reports whether path is one of dirs or inside one of them
+func withinAny(path string, dirs []string) bool {
+	if path == "" {
+		return false
+	}
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// composeFilesAvailable reports whether the project can be registered from workingDir: its
+// compose files still exist and registering the directory finds the same main compose file
+func composeFilesAvailable(workingDir string, configFiles []string) bool {
+	if workingDir == "" {
+		return false
+	}
+	composeFile, err := utils.GetComposeFilePath(workingDir)
+	if err != nil {
+		return false
+	}
+	if len(configFiles) == 0 {
+		return true
+	}
+	for _, file := range configFiles {
+		if _, err := os.Stat(file); err != nil {
+			return false
+		}
+	}
+	return filepath.Clean(configFiles[0]) == filepath.Clean(composeFile)
+}
@@ -0,0 +1,88 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+)
+
+// PullResult is the outcome of pulling the images of one project without printing its progress
+type PullResult struct {
+	// Changed lists the services whose image resolved to a new image ID after the pull
+	Changed []string
+	// Running reports whether the project had running containers to recreate
+	Running bool
+	Output  string
+	Err     error
+	// RegistryError is set when the pull failed on registry authentication
+	RegistryError *RegistryError
+}
+
+// PullProjectQuietly pulls the images of a project, capturing the compose output so several
+// projects can be pulled concurrently, and reports which service images changed
+func (cm *ComposeManager) PullProjectQuietly(projectDir string) PullResult {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return PullResult{Err: err}
+	}
+
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return PullResult{Err: err}
+	}
+
+	before := cm.serviceImageIDs(project)
+
+	args := utils.ComposeArgs(composeFilePath, "pull", "--quiet")
+	cmd, ctx, cancel := cm.dockerCommand(projectDir, args...)
+	defer cancel()
+	output, err := cmd.CombinedOutput()
+	result := PullResult{Output: strings.TrimSpace(string(output))}
+	if timeoutErr := cm.timeoutError(ctx, args); timeoutErr != nil {
+		result.Err = timeoutErr
+		return result
+	}
+	if err != nil {
+		result.Err = fmt.Errorf("failed to pull images: %v", err)
+		result.RegistryError = DetectRegistryError(result.Output)
+		return result
+	}
+
+	for service, id := range cm.serviceImageIDs(project) {
+		if before[service] != id {
+			result.Changed = append(result.Changed, service)
+		}
+	}
+	sort.Strings(result.Changed)
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	for _, cont := range containers {
+		if cont.State == "running" {
+			result.Running = true
+			break
+		}
+	}
+
+	return result
+}
+
+// serviceImageIDs returns the local image ID of each pulled service image
+func (cm *ComposeManager) serviceImageIDs(project *types.Project) map[string]string {
+	ids := make(map[string]string)
+	for _, service := range project.Services {
+		if service.Image == "" || service.Build != nil {
+			continue
+		}
+		if inspect, _, err := cm.dockerClient.ImageInspectWithRaw(cm.ctx, service.Image); err == nil {
+			ids[service.Name] = inspect.ID
+		}
+	}
+	return ids
+}
@@ -0,0 +1,88 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ContainerUptime represents how long a container has been running and how often it restarted
+type ContainerUptime struct {
+	Name         string
+	Service      string
+	State        string
+	StartedAt    time.Time
+	RestartCount int
+}
+
+// Uptime returns how long the container has been running, or 0 if it is not running
+func (cu ContainerUptime) Uptime() time.Duration {
+	if cu.State != "running" || cu.StartedAt.IsZero() {
+		return 0
+	}
+	return time.Since(cu.StartedAt)
+}
+
+// GetProjectUptimes returns the uptime of every container in the project, most recently started first
+func (cm *ComposeManager) GetProjectUptimes(projectDir string) ([]ContainerUptime, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := cm.GetProjectContainers(project.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var uptimes []ContainerUptime
+	for _, cont := range containers {
+		uptime := ContainerUptime{
+			Name:    strings.TrimPrefix(cont.Names[0], "/"),
+			Service: cont.Labels["com.docker.compose.service"],
+			State:   cont.State,
+		}
+
+		inspect, err := cm.dockerClient.ContainerInspect(cm.ctx, cont.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect container %s: %v", uptime.Name, err)
+		}
+
+		uptime.RestartCount = inspect.RestartCount
+		if inspect.State != nil {
+			if startedAt, err := time.Parse(time.RFC3339Nano, inspect.State.StartedAt); err == nil {
+				uptime.StartedAt = startedAt
+			}
+		}
+
+		uptimes = append(uptimes, uptime)
+	}
+
+	// Stopped containers have no uptime and sort first, next to recently restarted ones
+	sort.SliceStable(uptimes, func(i, j int) bool {
+		return uptimes[i].Uptime() < uptimes[j].Uptime()
+	})
+
+	return uptimes, nil
+}
+
+// FormatDuration renders a duration using its two most significant units, e.g. "3d 4h"
+func FormatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
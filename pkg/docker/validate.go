@@ -0,0 +1,163 @@
+package docker
+
+import (
+	"dockyard/pkg/utils"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/compose-spec/compose-go/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Severities of a PathIssue
+const (
+	PathIssueError   = "error"
+	PathIssueWarning = "warning"
+)
+
+// PathIssue is a host path referenced by a service that does not exist on disk
+type PathIssue struct {
+	Service  string
+	Kind     string // build context, dockerfile or bind mount
+	Path     string
+	Severity string
+	Detail   string
+}
+
+// ValidateProject checks that the build contexts, Dockerfiles and bind mount sources referenced
+// by each service exist, since compose only notices they are missing once the operation runs
+func ValidateProject(project *types.Project) []PathIssue {
+	var issues []PathIssue
+
+	for _, service := range project.Services {
+		if service.Build != nil {
+			issues = append(issues, validateBuild(project, service)...)
+		}
+
+		for _, volume := range service.Volumes {
+			if volume.Type != types.VolumeTypeBind || volume.Source == "" {
+				continue
+			}
+
+			source := projectPath(project, volume.Source)
+			if _, err := os.Stat(source); err == nil {
+				continue
+			}
+
+			issue := PathIssue{Service: service.Name, Kind: "bind mount", Path: source, Severity: PathIssueError,
+				Detail: "source does not exist"}
+			if volume.Bind != nil && volume.Bind.CreateHostPath {
+				issue.Severity = PathIssueWarning
+				issue.Detail = "source does not exist and will be created as an empty directory"
+			}
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// validateBuild checks the build context of a service and the Dockerfile inside it
+func validateBuild(project *types.Project, service types.ServiceConfig) []PathIssue {
+	buildContext := service.Build.Context
+	if buildContext == "" {
+		buildContext = "."
+	}
+	// Remote contexts are fetched by the builder
+	if strings.Contains(buildContext, "://") || strings.HasPrefix(buildContext, "git@") {
+		return nil
+	}
+
+	contextDir := projectPath(project, buildContext)
+	if info, err := os.Stat(contextDir); err != nil || !info.IsDir() {
+		return []PathIssue{{Service: service.Name, Kind: "build context", Path: contextDir, Severity: PathIssueError,
+			Detail: "directory not found"}}
+	}
+
+	if service.Build.DockerfileInline != "" {
+		return nil
+	}
+
+	dockerfile := service.Build.Dockerfile
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	if !filepath.IsAbs(dockerfile) {
+		dockerfile = filepath.Join(contextDir, dockerfile)
+	}
+	if _, err := os.Stat(dockerfile); err != nil {
+		return []PathIssue{{Service: service.Name, Kind: "dockerfile", Path: dockerfile, Severity: PathIssueError,
+			Detail: "file not found"}}
+	}
+
+	return nil
+}
+
+// projectPath resolves a path relative to the project's working directory
+func projectPath(project *types.Project, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(project.WorkingDir, path)
+}
+
+// Deprecation is a compose construct that still works but is deprecated, with a migration hint
+type Deprecation struct {
+	Service string // empty for top-level constructs
+	Feature string
+	Hint    string
+}
+
+// FindDeprecations reports deprecated constructs used by a project's compose file
+func FindDeprecations(projectDir string, project *types.Project) ([]Deprecation, error) {
+	composeFilePath, err := utils.GetComposeFilePath(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(composeFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compose file: %v", err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse compose file: %v", err)
+	}
+
+	var deprecations []Deprecation
+	if _, ok := raw["version"]; ok {
+		deprecations = append(deprecations, Deprecation{
+			Feature: "version",
+			Hint:    "remove the top-level version, compose ignores it and always uses the latest specification",
+		})
+	}
+
+	for _, service := range project.Services {
+		if len(service.Links) > 0 {
+			deprecations = append(deprecations, Deprecation{
+				Service: service.Name,
+				Feature: "links",
+				Hint:    "services on a shared network reach each other by name, use depends_on for start order and network aliases for extra names",
+			})
+		}
+		if len(service.VolumesFrom) > 0 {
+			deprecations = append(deprecations, Deprecation{
+				Service: service.Name,
+				Feature: "volumes_from",
+				Hint:    "declare a named volume under the top-level volumes key and mount it in each service",
+			})
+		}
+		if service.NetworkMode == "bridge" {
+			deprecations = append(deprecations, Deprecation{
+				Service: service.Name,
+				Feature: "network_mode: bridge",
+				Hint:    "drop network_mode to use the project's default network, the legacy bridge network has no DNS between services",
+			})
+		}
+	}
+
+	return deprecations, nil
+}
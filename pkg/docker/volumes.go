@@ -0,0 +1,89 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+)
+
+// ProjectVolume represents a named volume declared in a compose project
+type ProjectVolume struct {
+	Key        string // name of the volume in the compose file
+	Name       string // name of the volume on the daemon
+	Driver     string
+	External   bool
+	Exists     bool
+	Mountpoint string
+	Size       int64 // bytes, -1 when unknown
+}
+
+// GetProjectVolumes returns the named volumes declared in the project along with their state on the daemon
+func (cm *ComposeManager) GetProjectVolumes(projectDir string) ([]ProjectVolume, error) {
+	project, err := cm.LoadProject(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cm.ensureDockerRunning(); err != nil {
+		return nil, fmt.Errorf("docker is not accessible: %v", err)
+	}
+
+	sizes, err := cm.getVolumeSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []ProjectVolume
+	for key, config := range project.Volumes {
+		volume := ProjectVolume{
+			Key:      key,
+			Name:     config.Name,
+			Driver:   config.Driver,
+			External: config.External.External,
+			Size:     -1,
+		}
+		if volume.Driver == "" {
+			volume.Driver = "local"
+		}
+
+		inspect, err := cm.dockerClient.VolumeInspect(cm.ctx, config.Name)
+		if err != nil && !client.IsErrNotFound(err) {
+			return nil, fmt.Errorf("failed to inspect volume %s: %v", config.Name, err)
+		}
+
+		if err == nil {
+			volume.Exists = true
+			volume.Driver = inspect.Driver
+			volume.Mountpoint = inspect.Mountpoint
+			if size, ok := sizes[config.Name]; ok {
+				volume.Size = size
+			}
+		}
+
+		volumes = append(volumes, volume)
+	}
+
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Key < volumes[j].Key })
+
+	return volumes, nil
+}
+
+// getVolumeSizes returns the disk usage of every volume on the daemon by name
+func (cm *ComposeManager) getVolumeSizes() (map[string]int64, error) {
+	usage, err := cm.dockerClient.DiskUsage(cm.ctx, dockertypes.DiskUsageOptions{
+		Types: []dockertypes.DiskUsageObject{dockertypes.VolumeObject},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk usage: %v", err)
+	}
+
+	sizes := make(map[string]int64, len(usage.Volumes))
+	for _, vol := range usage.Volumes {
+		if vol.UsageData != nil {
+			sizes[vol.Name] = vol.UsageData.Size
+		}
+	}
+	return sizes, nil
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"hash/fnv"
 	"strings"
 
 	"github.com/charmbracelet/glamour"
@@ -18,6 +19,18 @@ var (
 	mutedColor   = lipgloss.Color("#6B7280") // Gray
 	accentColor  = lipgloss.Color("#F59E0B") // Amber
 
+	// Colors assigned to services in aggregated logs
+	servicePalette = []lipgloss.Color{
+		primaryColor,
+		successColor,
+		warningColor,
+		infoColor,
+		errorColor,
+		lipgloss.Color("#EC4899"), // Pink
+		lipgloss.Color("#14B8A6"), // Teal
+		accentColor,
+	}
+
 	// Base styles
 	baseStyle = lipgloss.NewStyle().
 			Padding(0, 1)
@@ -122,6 +135,16 @@ func RenderHighlightBox(content string) string {
 	return highlightBoxStyle.Render(content)
 }
 
+// RenderServicePrefix renders a log prefix padded to width, colored by service so that all
+// replicas of a service share the same color across runs
+func RenderServicePrefix(service, prefix string, width int) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(service))
+	color := servicePalette[hash.Sum32()%uint32(len(servicePalette))]
+
+	return lipgloss.NewStyle().Foreground(color).Bold(true).Render(fmt.Sprintf("%-*s |", width, prefix))
+}
+
 // Render lists with proper styling
 func RenderList(items []string) string {
 	var styledItems []string
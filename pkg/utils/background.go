@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+// BackgroundProcess is the state of a detached dockyard process, saved in the config directory
+type BackgroundProcess struct {
+	PID       int       `json:"pid"`
+	Project   string    `json:"project"`
+	OutDir    string    `json:"out_dir"`
+	StartedAt time.Time `json:"started_at"`
+	// ProcessStart is the start time the system reports for PID, see processStartTime
+	ProcessStart string `json:"process_start"`
+}
+
+// Running reports whether the process is still alive. After a reboot or once the process exited,
+// its PID can belong to an unrelated process, which is told apart by its start time.
+func (bp BackgroundProcess) Running() bool {
+	process, err := os.FindProcess(bp.PID)
+	if err != nil || !processAlive(process) {
+		return false
+	}
+	started, err := processStartTime(bp.PID)
+	return err == nil && bp.ProcessStart != "" && started == bp.ProcessStart
+}
+
+// backgroundStatePath returns the file holding the state of a project's background log capture
+func backgroundStatePath(project string) (string, error) {
+	return ConfigDir("background", project+".json")
+}
+
+// StartBackground runs dockyard again with args as a detached process that outlives this one,
+// and records its PID for the project
+func StartBackground(project, outDir string, args []string) (BackgroundProcess, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return BackgroundProcess{}, err
+	}
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_RDWR, 0)
+	if err != nil {
+		return BackgroundProcess{}, err
+	}
+	defer devNull.Close()
+
+	cmd := exec.Command(executable, args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = devNull, devNull, devNull
+	cmd.SysProcAttr = detachedProcAttr()
+	if err := cmd.Start(); err != nil {
+		return BackgroundProcess{}, fmt.Errorf("failed to start background process: %v", err)
+	}
+
+	started, err := processStartTime(cmd.Process.Pid)
+	if err != nil {
+		// Without its start time the process could never be stopped safely
+		cmd.Process.Kill()
+		return BackgroundProcess{}, fmt.Errorf("failed to identify background process: %v", err)
+	}
+
+	process := BackgroundProcess{PID: cmd.Process.Pid, Project: project, OutDir: outDir, StartedAt: time.Now(), ProcessStart: started}
+	if err := cmd.Process.Release(); err != nil {
+		return process, err
+	}
+	return process, saveBackgroundProcess(process)
+}
+
+func saveBackgroundProcess(process BackgroundProcess) error {
+	path, err := backgroundStatePath(process.Project)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(process, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadBackgroundProcess returns the recorded background process of a project, if any
+func LoadBackgroundProcess(project string) (BackgroundProcess, bool, error) {
+	path, err := backgroundStatePath(project)
+	if err != nil {
+		return BackgroundProcess{}, false, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return BackgroundProcess{}, false, nil
+	}
+	if err != nil {
+		return BackgroundProcess{}, false, err
+	}
+
+	var process BackgroundProcess
+	if err := json.Unmarshal(data, &process); err != nil {
+		return BackgroundProcess{}, false, fmt.Errorf("invalid state in %s: %v", path, err)
+	}
+	return process, true, nil
+}
+
+// StopBackgroundProcess terminates the background process of a project and forgets it. A process
+// that already exited, or whose PID now belongs to another process, is only forgotten.
+func StopBackgroundProcess(process BackgroundProcess) error {
+	if process.Running() {
+		if p, err := os.FindProcess(process.PID); err == nil {
+			if err := terminateProcess(p); err != nil {
+				return fmt.Errorf("failed to stop process %d: %v", process.PID, err)
+			}
+		}
+	}
+
+	path, err := backgroundStatePath(process.Project)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
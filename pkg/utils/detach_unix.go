@@ -0,0 +1,38 @@
+//go:build !windows
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// detachedProcAttr starts the process in its own session so it survives the terminal closing
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
+// processAlive reports whether the process exists, signal 0 only checks for it
+func processAlive(process *os.Process) bool {
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// processStartTime returns when a process started, as reported by ps, which tells it apart from a
+// later process that reuses its PID
+func processStartTime(pid int) (string, error) {
+	output, err := exec.Command("ps", "-o", "lstart=", "-p", strconv.Itoa(pid)).Output()
+	started := strings.TrimSpace(string(output))
+	if err != nil || started == "" {
+		return "", fmt.Errorf("process %d not found", pid)
+	}
+	return started, nil
+}
+
+// terminateProcess asks the process to exit so it can clean up
+func terminateProcess(process *os.Process) error {
+	return process.Signal(syscall.SIGTERM)
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+const (
+	createNewProcessGroup = 0x00000200
+	detachedProcess       = 0x00000008
+)
+
+// detachedProcAttr starts the process without a console so it survives the terminal closing
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: createNewProcessGroup | detachedProcess}
+}
+
+// processAlive reports whether the process exists. FindProcess already fails for exited
+// processes on Windows, so only the exit code is left to check.
+func processAlive(process *os.Process) bool {
+	handle, err := syscall.OpenProcess(syscall.PROCESS_QUERY_INFORMATION, false, uint32(process.Pid))
+	if err != nil {
+		return false
+	}
+	defer syscall.CloseHandle(handle)
+
+	var exitCode uint32
+	const stillActive = 259
+	return syscall.GetExitCodeProcess(handle, &exitCode) == nil && exitCode == stillActive
+}
+
+// processStartTime returns when a process was created, which tells it apart from a later process
+// that reuses its PID
+func processStartTime(pid int) (string, error) {
+	handle, err := syscall.OpenProcess(syscall.PROCESS_QUERY_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return "", err
+	}
+	defer syscall.CloseHandle(handle)
+
+	var creation, exit, kernel, user syscall.Filetime
+	if err := syscall.GetProcessTimes(handle, &creation, &exit, &kernel, &user); err != nil {
+		return "", err
+	}
+	return fmt.Sprint(creation.Nanoseconds()), nil
+}
+
+// terminateProcess kills the process, Windows has no signal to ask it to exit
+func terminateProcess(process *os.Process) error {
+	return process.Kill()
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// includeEntry is an item of a compose file's include list, in its short or long syntax
+type includeEntry struct {
+	Path []string
+}
+
+// UnmarshalYAML accepts a plain path or a mapping whose path is a string or a list
+func (e *includeEntry) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		e.Path = []string{node.Value}
+		return nil
+	}
+
+	var long struct {
+		Path yaml.Node `yaml:"path"`
+	}
+	if err := node.Decode(&long); err != nil {
+		return err
+	}
+	if long.Path.Kind == yaml.ScalarNode {
+		e.Path = []string{long.Path.Value}
+		return nil
+	}
+	return long.Path.Decode(&e.Path)
+}
+
+// ComposeIncludes returns every local file pulled in through the include lists of a compose
+// file, recursively and in load order. It fails on an include that does not exist or that leads
+// back to a file already being included. Remote includes and paths using variables are left to
+// compose.
+func ComposeIncludes(composeFilePath string) ([]string, error) {
+	var included []string
+	err := collectIncludes(composeFilePath, []string{composeFilePath}, &included)
+	return included, err
+}
+
+// collectIncludes appends the includes of file to included, chain being the files that led to it
+func collectIncludes(file string, chain []string, included *[]string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read compose file %s: %v", file, err)
+	}
+
+	var model struct {
+		Include []includeEntry `yaml:"include"`
+	}
+	if err := yaml.Unmarshal(content, &model); err != nil {
+		return fmt.Errorf("failed to parse include list of %s: %v", file, err)
+	}
+
+	for _, entry := range model.Include {
+		for _, path := range entry.Path {
+			if strings.Contains(path, "://") || strings.Contains(path, "$") {
+				continue
+			}
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(filepath.Dir(file), path)
+			}
+			path = filepath.Clean(path)
+
+			if slices.Contains(chain, path) {
+				return fmt.Errorf("circular include: %s", strings.Join(append(chain, path), " → "))
+			}
+			if _, err := os.Stat(path); err != nil {
+				return fmt.Errorf("%s includes %s, which does not exist", file, path)
+			}
+
+			if !slices.Contains(*included, path) {
+				*included = append(*included, path)
+			}
+			if err := collectIncludes(path, append(slices.Clone(chain), path), included); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
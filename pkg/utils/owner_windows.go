@@ -0,0 +1,10 @@
+//go:build windows
+
+package utils
+
+import "os"
+
+// FileOwner returns the numeric owner and group of a file, which Windows does not expose
+func FileOwner(info os.FileInfo) (uid int, gid int, ok bool) {
+	return 0, 0, false
+}
@@ -5,10 +5,66 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
+	"slices"
 	"strings"
 )
 
+// ProjectDirOverride replaces the resolved directory of the targeted project for a single invocation
+var ProjectDirOverride string
+
+// ComposeFileOverride replaces the compose file found in the project directory for a single invocation
+var ComposeFileOverride string
+
+// overrideTarget is the registered path of the project the overrides apply to, and
+// overrideTargetDir the directory it resolved to. Other projects are never overridden.
+var (
+	overrideTarget    string
+	overrideTargetDir string
+)
+
+// HasProjectOverrides reports whether --project-dir or --file was given
+func HasProjectOverrides() bool {
+	return ProjectDirOverride != "" || ComposeFileOverride != ""
+}
+
+// SetOverrideTarget scopes the --project-dir and --file overrides to the project registered at
+// path. The overrides apply to a single project, so targeting a second one is an error.
+func SetOverrideTarget(path string) error {
+	if !HasProjectOverrides() {
+		return nil
+	}
+	if overrideTarget != "" && overrideTarget != path {
+		return fmt.Errorf("--project-dir and --file apply to a single project")
+	}
+	overrideTarget = path
+	return nil
+}
+
+// ResolveHomeDir returns the local directory of a project path, expanding a leading ~.
+// Remote compose sources (HTTP URLs and git:: references) resolve to their cache directory;
+// they are only fetched when the project is loaded, see FetchRemoteProject.
+// The --project-dir override takes precedence for the project targeted by the overrides.
 func ResolveHomeDir(path string) (string, error) {
+	if overrideTarget != "" && path == overrideTarget {
+		dir := ProjectDirOverride
+		if dir == "" {
+			resolved, err := resolveProjectPath(path)
+			if err != nil {
+				return "", err
+			}
+			dir = resolved
+		}
+		overrideTargetDir = filepath.Clean(dir)
+		return dir, nil
+	}
+	return resolveProjectPath(path)
+}
+
+// resolveProjectPath returns the local directory of a project path, ignoring the overrides
+func resolveProjectPath(path string) (string, error) {
+	if IsRemoteSource(path) {
+		return RemoteSourceDir(path)
+	}
 	if !strings.HasPrefix(path, "~") {
 		return path, nil
 	}
@@ -21,7 +77,12 @@ func ResolveHomeDir(path string) (string, error) {
 
 // GetComposeFilePath finds the Docker Compose file in the project directory
 // Supports all standard Docker Compose file names
+// The --file override is returned for the directory of the project targeted by the overrides
 func GetComposeFilePath(projectDir string) (string, error) {
+	if composeFileOverridden(projectDir) {
+		return ComposeFileOverride, nil
+	}
+
 	// List of compose file names in order of preference
 	composeFiles := []string{
 		"compose.yaml",
@@ -41,8 +102,38 @@ func GetComposeFilePath(projectDir string) (string, error) {
 		projectDir, strings.Join(composeFiles, ", "))
 }
 
-// GetAllComposeFiles returns all Docker Compose files found in the directory
+// composeFileOverridden reports whether the --file override replaces the compose file of projectDir
+func composeFileOverridden(projectDir string) bool {
+	return ComposeFileOverride != "" && overrideTargetDir != "" && filepath.Clean(projectDir) == overrideTargetDir
+}
+
+// ComposeArgs returns the docker arguments running a compose command on a compose file. The
+// --file override lives outside the project directory, so compose is given that directory
+// explicitly to agree with the loaded project on its name and relative paths.
+func ComposeArgs(composeFilePath string, command ...string) []string {
+	args := []string{"compose", "-f", composeFilePath}
+	if ComposeFileOverride != "" && composeFilePath == ComposeFileOverride && overrideTargetDir != "" {
+		args = append(args, "--project-directory", overrideTargetDir)
+	}
+	return append(args, command...)
+}
+
+// GetAllComposeFiles returns all Docker Compose files found in the directory, followed by the
+// files they include
 func GetAllComposeFiles(projectDir string) ([]string, error) {
+	files, err := topLevelComposeFiles(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	return appendIncludes(files)
+}
+
+// topLevelComposeFiles returns the Docker Compose files found in the directory
+func topLevelComposeFiles(projectDir string) ([]string, error) {
+	if composeFileOverridden(projectDir) {
+		return []string{ComposeFileOverride}, nil
+	}
+
 	composeFiles := []string{
 		"compose.yaml",
 		"compose.yml",
@@ -67,8 +158,81 @@ func GetAllComposeFiles(projectDir string) ([]string, error) {
 	return foundFiles, nil
 }
 
+// appendIncludes adds the files included by the given compose files. Compose resolves includes
+// itself, so only the top-level files are passed to docker.
+func appendIncludes(files []string) ([]string, error) {
+	all := files
+	for _, file := range files {
+		included, err := ComposeIncludes(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range included {
+			if !slices.Contains(all, path) {
+				all = append(all, path)
+			}
+		}
+	}
+	return all, nil
+}
+
 // HasDockerComposeFiles checks if the directory contains any Docker Compose files
 func HasDockerComposeFiles(projectDir string) bool {
-	files, err := GetAllComposeFiles(projectDir)
+	files, err := topLevelComposeFiles(projectDir)
 	return err == nil && len(files) > 0
 }
+
+// SetProjectOverrides validates and applies the --project-dir and --file overrides.
+// The compose file wins for the compose target, the directory is used as working directory.
+func SetProjectOverrides(projectDir, composeFile string) error {
+	if composeFile != "" {
+		path, err := absolutePath(composeFile)
+		if err != nil {
+			return err
+		}
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			return fmt.Errorf("compose file %s does not exist", composeFile)
+		}
+		ComposeFileOverride = path
+	}
+
+	if projectDir != "" {
+		path, err := absolutePath(projectDir)
+		if err != nil {
+			return err
+		}
+		info, err := os.Stat(path)
+		if err != nil || !info.IsDir() {
+			return fmt.Errorf("project directory %s does not exist", projectDir)
+		}
+		if !HasDockerComposeFiles(path) {
+			return fmt.Errorf("no docker-compose file found in %s", projectDir)
+		}
+		ProjectDirOverride = path
+	}
+
+	return nil
+}
+
+// absolutePath expands a leading ~ and makes a path absolute
+func absolutePath(path string) (string, error) {
+	if strings.HasPrefix(path, "~") {
+		usr, err := user.Current()
+		if err != nil {
+			return "", err
+		}
+		path = strings.Replace(path, "~", usr.HomeDir, 1)
+	}
+	return filepath.Abs(path)
+}
+
+// ConfigDir returns the dockyard directory in the user configuration directory, joined with
+// the given elements, e.g. ConfigDir("sessions")
+func ConfigDir(elem ...string) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(append([]string{configDir, "dockyard"}, elem...)...), nil
+}
@@ -0,0 +1,24 @@
+//go:build !windows
+
+package utils
+
+import (
+	"os"
+	"syscall"
+)
+
+// ProcessGroupAttr starts the process in a new process group so it can be killed with its children
+func ProcessGroupAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
+
+// KillProcessGroup kills a process started with ProcessGroupAttr and every process it spawned
+func KillProcessGroup(process *os.Process) error {
+	return syscall.Kill(-process.Pid, syscall.SIGKILL)
+}
+
+// InterruptProcessGroup sends SIGINT to a process started with ProcessGroupAttr and its children,
+// as the terminal would have done for Ctrl-C if it were in the foreground group
+func InterruptProcessGroup(process *os.Process) error {
+	return syscall.Kill(-process.Pid, syscall.SIGINT)
+}
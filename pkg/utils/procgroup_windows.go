@@ -0,0 +1,30 @@
+//go:build windows
+
+package utils
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// ProcessGroupAttr starts the process in a new process group so it can be killed with its children
+func ProcessGroupAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// KillProcessGroup kills a process started with ProcessGroupAttr and every process it spawned
+func KillProcessGroup(process *os.Process) error {
+	return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(process.Pid)).Run()
+}
+
+// InterruptProcessGroup sends CTRL_BREAK to a process started with ProcessGroupAttr, since a new
+// process group does not receive the console's Ctrl-C
+func InterruptProcessGroup(process *os.Process) error {
+	r, _, err := syscall.NewLazyDLL("kernel32.dll").NewProc("GenerateConsoleCtrlEvent").Call(syscall.CTRL_BREAK_EVENT, uintptr(process.Pid))
+	if r == 0 {
+		return err
+	}
+	return nil
+}
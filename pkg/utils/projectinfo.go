@@ -38,9 +38,10 @@ var (
 )
 
 type WelcomeConfig struct {
-	ShowStatus bool
-	ShowTip    bool
-	ShowTime   bool
+	ShowStatus    bool
+	ShowTip       bool
+	ShowTime      bool
+	DockerContext string
 }
 
 var defaultWelcome = &WelcomeConfig{
@@ -49,8 +50,11 @@ var defaultWelcome = &WelcomeConfig{
 	ShowTime:   true,
 }
 
-func ProjectInfo() {
-	displayWelcome(defaultWelcome)
+// ProjectInfo displays the welcome banner, including the active docker context when known
+func ProjectInfo(dockerContext string) {
+	config := *defaultWelcome
+	config.DockerContext = dockerContext
+	displayWelcome(&config)
 }
 
 func displayWelcome(config *WelcomeConfig) {
@@ -69,6 +73,14 @@ func displayWelcome(config *WelcomeConfig) {
 		fmt.Printf("%s\n", timestamp)
 	}
 
+	if config.ShowStatus && config.DockerContext != "" {
+		context := lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Faint(true).
+			Render(fmt.Sprintf("Docker context: %s", config.DockerContext))
+		fmt.Printf("%s\n", context)
+	}
+
 	fmt.Println()
 
 	if config.ShowTip {
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// RedactedValue replaces secret values in redacted output
+const RedactedValue = "****"
+
+// SecretKeysEnv names the environment variable holding a comma-separated list of key
+// patterns that replaces DefaultSecretKeyPatterns
+const SecretKeysEnv = "DOCKYARD_SECRET_KEYS"
+
+// DefaultSecretKeyPatterns are the words identifying secret environment keys
+var DefaultSecretKeyPatterns = []string{"PASSWORD", "TOKEN", "SECRET", "KEY"}
+
+// nonSecretSuffixes end keys that describe a secret rather than hold it, e.g. API_KEY_HEADER
+// or DB_PASSWORD_FILE
+var nonSecretSuffixes = []string{"FILE", "PATH", "URL", "HEADER", "NAME", "ID"}
+
+// minSecretLength is the shortest value replaced verbatim, shorter values like "1" or
+// "true" would mangle unrelated output
+const minSecretLength = 4
+
+// assignmentPattern matches KEY=value and KEY: value pairs in free text
+var assignmentPattern = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)(=|:\s*)("[^"]*"|'[^']*'|\S+)`)
+
+// Redactor hides the values of secret environment keys in text
+type Redactor struct {
+	patterns []string
+	secrets  []string
+}
+
+// SecretKeyPatterns returns the configured secret key patterns
+func SecretKeyPatterns() []string {
+	value := strings.TrimSpace(os.Getenv(SecretKeysEnv))
+	if value == "" {
+		return DefaultSecretKeyPatterns
+	}
+
+	var patterns []string
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, strings.ToUpper(pattern))
+		}
+	}
+	return patterns
+}
+
+// NewRedactor creates a redactor that hides the values of the secret keys found in env
+func NewRedactor(env map[string]string) *Redactor {
+	r := &Redactor{patterns: SecretKeyPatterns()}
+
+	for key, value := range env {
+		if r.IsSecretKey(key) && len(value) >= minSecretLength {
+			r.secrets = append(r.secrets, value)
+		}
+	}
+
+	// Replace longer secrets first so a secret containing another one is hidden entirely
+	sort.Slice(r.secrets, func(i, j int) bool {
+		return len(r.secrets[i]) > len(r.secrets[j])
+	})
+	return r
+}
+
+// IsSecretKey reports whether key contains one of the secret key patterns as whole '_'-separated
+// words, so MONKEY or KEYCLOAK_URL are not secret keys, and does not end with a word naming
+// something else than the secret itself
+func (r *Redactor) IsSecretKey(key string) bool {
+	words := "_" + strings.ToUpper(key) + "_"
+	for _, suffix := range nonSecretSuffixes {
+		if strings.HasSuffix(words, "_"+suffix+"_") {
+			return false
+		}
+	}
+
+	for _, pattern := range r.patterns {
+		if strings.Contains(words, "_"+pattern+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactValue returns value, or RedactedValue if key is a secret key
+func (r *Redactor) RedactValue(key, value string) string {
+	if value != "" && r.IsSecretKey(key) {
+		return RedactedValue
+	}
+	return value
+}
+
+// Redact replaces known secret values and the values of secret key assignments in s
+func (r *Redactor) Redact(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, RedactedValue)
+	}
+
+	return assignmentPattern.ReplaceAllStringFunc(s, func(match string) string {
+		parts := assignmentPattern.FindStringSubmatch(match)
+		if !r.IsSecretKey(parts[1]) || parts[3] == RedactedValue {
+			return match
+		}
+		return parts[1] + parts[2] + RedactedValue
+	})
+}
@@ -0,0 +1,314 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GitSourcePrefix marks a project path as a git repository, e.g.
+// git::https://github.com/org/stacks.git//api?ref=main
+const GitSourcePrefix = "git::"
+
+// RefreshRemoteSources forces remote compose sources to be downloaded again instead of
+// revalidating the cached copy
+var RefreshRemoteSources bool
+
+// remoteSource is the outcome of fetching a remote source, kept for the rest of the run
+type remoteSource struct {
+	dir string
+	err error
+}
+
+var (
+	remoteSourcesMu sync.Mutex
+	remoteSources   = make(map[string]remoteSource)
+	remoteWarnings  []string
+	// remoteProjectDirs maps the project directory of a remote source back to the source
+	remoteProjectDirs = make(map[string]string)
+)
+
+// invalidProjectNameChars matches the characters compose does not accept in a project name
+var invalidProjectNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// IsRemoteSource reports whether a project path points to an HTTP(S) compose file or a git repository
+func IsRemoteSource(source string) bool {
+	return strings.HasPrefix(source, "http://") ||
+		strings.HasPrefix(source, "https://") ||
+		strings.HasPrefix(source, GitSourcePrefix)
+}
+
+// RemoteSourceDir returns the local project directory of a remote source, without fetching it.
+// The directory is named after the source, e.g. api for a git subdirectory, since compose
+// derives the project name from it.
+func RemoteSourceDir(source string) (string, error) {
+	cacheDir, err := RemoteCacheDir(source)
+	if err != nil {
+		return "", err
+	}
+
+	var dir string
+	if strings.HasPrefix(source, GitSourcePrefix) {
+		repository, subdir, _ := parseGitSource(strings.TrimPrefix(source, GitSourcePrefix))
+		dir = filepath.Join(gitCloneDir(cacheDir, repository), filepath.FromSlash(subdir))
+	} else {
+		dir, _, err = httpSourceLayout(source, cacheDir)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	remoteSourcesMu.Lock()
+	remoteProjectDirs[filepath.Clean(dir)] = source
+	remoteSourcesMu.Unlock()
+	return dir, nil
+}
+
+// FetchRemoteProject downloads the remote source a project directory was resolved from with
+// ResolveHomeDir to the cache. Directories of local projects are left alone.
+func FetchRemoteProject(projectDir string) error {
+	remoteSourcesMu.Lock()
+	source, ok := remoteProjectDirs[filepath.Clean(projectDir)]
+	remoteSourcesMu.Unlock()
+	if !ok {
+		return nil
+	}
+	_, err := fetchRemoteSource(source)
+	return err
+}
+
+// fetchRemoteSource downloads a remote compose source to the cache and returns the local
+// project directory. Each source is fetched at most once per run, failures included. When the
+// source cannot be reached, a previously cached copy is used and a warning is kept for
+// RemoteSourceWarnings rather than printed, so it does not break progress output.
+func fetchRemoteSource(source string) (string, error) {
+	remoteSourcesMu.Lock()
+	defer remoteSourcesMu.Unlock()
+
+	if fetched, ok := remoteSources[source]; ok {
+		return fetched.dir, fetched.err
+	}
+
+	cacheDir, err := RemoteCacheDir(source)
+	if err != nil {
+		return "", err
+	}
+
+	var dir string
+	if strings.HasPrefix(source, GitSourcePrefix) {
+		dir, err = fetchGitSource(strings.TrimPrefix(source, GitSourcePrefix), cacheDir)
+	} else {
+		dir, err = fetchHTTPSource(source, cacheDir)
+	}
+
+	remoteSources[source] = remoteSource{dir: dir, err: err}
+	return dir, err
+}
+
+// RemoteSourceWarnings returns the warnings collected while fetching remote sources and
+// forgets them
+func RemoteSourceWarnings() []string {
+	remoteSourcesMu.Lock()
+	defer remoteSourcesMu.Unlock()
+
+	warnings := remoteWarnings
+	remoteWarnings = nil
+	return warnings
+}
+
+// remoteProjectName turns the last element of a source path into a compose project name
+func remoteProjectName(name string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".git")
+	name = strings.Trim(invalidProjectNameChars.ReplaceAllString(name, "-"), "-")
+	if name == "" {
+		return "remote"
+	}
+	return name
+}
+
+// RemoteCacheDir returns the cache directory of a remote source, without fetching it
+func RemoteCacheDir(source string) (string, error) {
+	baseDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate cache directory: %v", err)
+	}
+
+	sum := sha256.Sum256([]byte(source))
+	return filepath.Join(baseDir, "dockyard", "remote", hex.EncodeToString(sum[:8])), nil
+}
+
+// httpSourceLayout returns the project directory and compose file name of an HTTP source. The
+// directory is named after the directory of the file in the URL, or the host.
+func httpSourceLayout(source, cacheDir string) (string, string, error) {
+	parsed, err := url.Parse(source)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid compose URL %s: %v", source, err)
+	}
+
+	name := path.Base(path.Dir(parsed.Path))
+	if name == "/" || name == "." {
+		name = parsed.Hostname()
+	}
+
+	// Keep recognised compose file names so GetComposeFilePath finds the file
+	filename := "compose.yaml"
+	switch base := path.Base(parsed.Path); base {
+	case "compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml":
+		filename = base
+	}
+
+	return filepath.Join(cacheDir, remoteProjectName(name)), filename, nil
+}
+
+// fetchHTTPSource downloads a compose file, revalidating the cached copy with its ETag
+func fetchHTTPSource(source, cacheDir string) (string, error) {
+	cacheDir, filename, err := httpSourceLayout(source, cacheDir)
+	if err != nil {
+		return "", err
+	}
+
+	composePath := filepath.Join(cacheDir, filename)
+	etagPath := filepath.Join(cacheDir, ".etag")
+	_, statErr := os.Stat(composePath)
+	cached := statErr == nil
+
+	req, err := http.NewRequest(http.MethodGet, source, nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid compose URL %s: %v", source, err)
+	}
+	if cached && !RefreshRemoteSources {
+		if etag, err := os.ReadFile(etagPath); err == nil {
+			req.Header.Set("If-None-Match", strings.TrimSpace(string(etag)))
+		}
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return useCachedSource(source, cacheDir, cached, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached:
+		return cacheDir, nil
+	case resp.StatusCode != http.StatusOK:
+		return useCachedSource(source, cacheDir, cached, fmt.Errorf("HTTP %d", resp.StatusCode))
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return useCachedSource(source, cacheDir, cached, err)
+	}
+
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create cache directory: %v", err)
+	}
+	if err := os.WriteFile(composePath, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to cache compose file: %v", err)
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		os.WriteFile(etagPath, []byte(etag), 0644)
+	} else {
+		os.Remove(etagPath)
+	}
+
+	return cacheDir, nil
+}
+
+// parseGitSource splits a git source of the form
+// <repository>[//<subdirectory>][?ref=<branch or tag>] into its parts
+func parseGitSource(source string) (repository, subdir, ref string) {
+	repository = source
+	if i := strings.LastIndex(source, "?ref="); i >= 0 {
+		repository, ref = source[:i], source[i+len("?ref="):]
+	}
+
+	// Skip the scheme separator so only the subdirectory separator is matched
+	if i := strings.Index(repository, "://"); i >= 0 {
+		if j := strings.Index(repository[i+3:], "//"); j >= 0 {
+			repository, subdir = repository[:i+3+j], repository[i+3+j+2:]
+		}
+	} else if j := strings.Index(repository, "//"); j >= 0 {
+		repository, subdir = repository[:j], repository[j+2:]
+	}
+	return repository, subdir, ref
+}
+
+// gitCloneDir returns the directory a repository is cloned to, named after the repository
+func gitCloneDir(cacheDir, repository string) string {
+	return filepath.Join(cacheDir, remoteProjectName(path.Base(strings.TrimSuffix(repository, "/"))))
+}
+
+// fetchGitSource clones or updates a shallow copy of a repository
+func fetchGitSource(source, cacheDir string) (string, error) {
+	repository, subdir, ref := parseGitSource(source)
+
+	cacheDir = gitCloneDir(cacheDir, repository)
+	projectDir := filepath.Join(cacheDir, filepath.FromSlash(subdir))
+	_, statErr := os.Stat(filepath.Join(cacheDir, ".git"))
+	cached := statErr == nil
+
+	if cached && RefreshRemoteSources {
+		if err := os.RemoveAll(cacheDir); err != nil {
+			return "", fmt.Errorf("failed to clear cached repository: %v", err)
+		}
+		cached = false
+	}
+
+	if !cached {
+		args := []string{"clone", "--depth", "1"}
+		if ref != "" {
+			args = append(args, "--branch", ref)
+		}
+		args = append(args, "--", repository, cacheDir)
+
+		if output, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			os.RemoveAll(cacheDir)
+			return "", fmt.Errorf("failed to clone %s: %s", repository, strings.TrimSpace(string(output)))
+		}
+		return projectDir, nil
+	}
+
+	fetchRef := ref
+	if fetchRef == "" {
+		fetchRef = "HEAD"
+	}
+	if output, err := exec.Command("git", "-C", cacheDir, "fetch", "--depth", "1", "--", "origin", fetchRef).CombinedOutput(); err != nil {
+		return useCachedSource(repository, projectDir, true, fmt.Errorf("%s", strings.TrimSpace(string(output))))
+	}
+
+	// Only move the checkout when the remote commit changed
+	head, _ := exec.Command("git", "-C", cacheDir, "rev-parse", "HEAD").Output()
+	fetched, _ := exec.Command("git", "-C", cacheDir, "rev-parse", "FETCH_HEAD").Output()
+	if strings.TrimSpace(string(head)) != strings.TrimSpace(string(fetched)) {
+		if output, err := exec.Command("git", "-C", cacheDir, "checkout", "--quiet", "--force", "FETCH_HEAD").CombinedOutput(); err != nil {
+			return "", fmt.Errorf("failed to update %s: %s", repository, strings.TrimSpace(string(output)))
+		}
+	}
+
+	return projectDir, nil
+}
+
+// useCachedSource falls back to the cached copy of a source that could not be fetched. It is
+// called with remoteSourcesMu held.
+func useCachedSource(source, dir string, cached bool, fetchErr error) (string, error) {
+	if !cached {
+		return "", fmt.Errorf("failed to fetch %s: %v", source, fetchErr)
+	}
+
+	remoteWarnings = append(remoteWarnings, fmt.Sprintf("Failed to fetch %s (%v), using cached copy", source, fetchErr))
+	return dir, nil
+}
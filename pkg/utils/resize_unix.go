@@ -0,0 +1,14 @@
+//go:build !windows
+
+package utils
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// NotifyResize relays terminal window size changes to ch
+func NotifyResize(ch chan<- os.Signal) {
+	signal.Notify(ch, syscall.SIGWINCH)
+}
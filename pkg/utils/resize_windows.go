@@ -0,0 +1,9 @@
+//go:build windows
+
+package utils
+
+import "os"
+
+// NotifyResize does nothing on Windows, whose consoles do not signal size changes, so the
+// size at the start of the session is kept
+func NotifyResize(ch chan<- os.Signal) {}
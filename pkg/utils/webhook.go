@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookURLEnv names the environment variable holding the default webhook URL
+const WebhookURLEnv = "DOCKYARD_WEBHOOK_URL"
+
+// WebhookTimeout bounds how long a delivery waits for the webhook endpoint
+const WebhookTimeout = 2 * time.Second
+
+// WebhookPayload is the JSON document posted to the webhook after an operation.
+// Its fields are part of the documented format and must stay stable.
+type WebhookPayload struct {
+	Project   string    `json:"project"`
+	Action    string    `json:"action"`
+	Success   bool      `json:"success"`
+	Error     string    `json:"error,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	// Text and Content carry a readable summary, the message fields of Slack and Discord
+	Text    string `json:"text"`
+	Content string `json:"content"`
+}
+
+// NewWebhookPayload creates the payload of an operation result. Secret values in the
+// error message are redacted.
+func NewWebhookPayload(project, action string, err error) WebhookPayload {
+	payload := WebhookPayload{
+		Project:   project,
+		Action:    action,
+		Success:   err == nil,
+		Timestamp: time.Now().UTC(),
+	}
+	payload.Text = fmt.Sprintf("✅ %s of %s succeeded", action, project)
+	if err != nil {
+		payload.Error = NewRedactor(nil).Redact(err.Error())
+		payload.Text = fmt.Sprintf("❌ %s of %s failed: %s", action, project, payload.Error)
+	}
+	payload.Content = payload.Text
+	return payload
+}
+
+// SendWebhook posts the payload as JSON to url. Delivery failures are returned but never
+// retried, so a slow or unreachable endpoint costs at most WebhookTimeout.
+func SendWebhook(url string, payload WebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: WebhookTimeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to deliver webhook: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook endpoint returned HTTP %d", resp.StatusCode)
+	}
+	return nil
+}